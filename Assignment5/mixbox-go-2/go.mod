@@ -0,0 +1,7 @@
+module github.com/timf34/mixbox-go/demos
+
+go 1.23.4
+
+require github.com/timf34/mixbox-go v0.0.0
+
+replace github.com/timf34/mixbox-go => ../mixbox-go
@@ -1,42 +1,31 @@
+//go:build ignore
+
 package main
 
 import (
+	"context"
 	"encoding/json"
+	"flag"
 	"fmt"
 	"html/template"
 	"log"
-	"mixbox"
 	"net/http"
+	"os"
+	"os/signal"
 	"strconv"
-)
+	"syscall"
 
-// Hex to RGB conversion
-func hexToRGB(hex string) ([3]uint8, error) {
-	var r, g, b uint8
-	if hex[0] == '#' {
-		hex = hex[1:]
-	}
-	if len(hex) != 6 {
-		return [3]uint8{}, fmt.Errorf("invalid hex color format: %s", hex)
-	}
-	r64, err := strconv.ParseUint(hex[0:2], 16, 8)
-	if err != nil {
-		return [3]uint8{}, err
-	}
-	g64, err := strconv.ParseUint(hex[2:4], 16, 8)
-	if err != nil {
-		return [3]uint8{}, err
-	}
-	b64, err := strconv.ParseUint(hex[4:6], 16, 8)
-	if err != nil {
-		return [3]uint8{}, err
-	}
-	return [3]uint8{uint8(r64), uint8(g64), uint8(b64)}, nil
-}
+	"github.com/timf34/mixbox-go/api"
+	"github.com/timf34/mixbox-go/colorparse"
+	"github.com/timf34/mixbox-go/live"
+	"github.com/timf34/mixbox-go/mixbox"
+	"github.com/timf34/mixbox-go/render"
+	"github.com/timf34/mixbox-go/server"
+)
 
 // RGB to Hex conversion
 func rgbToHex(rgb [3]uint8) string {
-	return fmt.Sprintf("#%02x%02x%02x", rgb[0], rgb[1], rgb[2])
+	return colorparse.FromRGB(rgb).Hex()
 }
 
 // ColorResult represents the result of a color mixing operation
@@ -48,14 +37,6 @@ type ColorResult struct {
 }
 
 func main() {
-	// Initialize Mixbox with the compressed LUT
-	// In a real implementation, you would load the actual LUT data
-	lutData := "xNrFm..." // Replace with actual compressed data
-	err := mixbox.InitLUT(lutData)
-	if err != nil {
-		log.Fatalf("Failed to initialize mixbox LUT: %v", err)
-	}
-
 	// Define HTML template for the web interface
 	const htmlTemplate = `
 <!DOCTYPE html>
@@ -172,17 +153,75 @@ func main() {
         // Update UI based on inputs
         function updateColor1() {
             color1Display.style.backgroundColor = color1Input.value;
-            updateMixing();
+            refresh(true);
         }
 
         function updateColor2() {
             color2Display.style.backgroundColor = color2Input.value;
-            updateMixing();
+            refresh(true);
         }
 
         function updateRatio() {
             ratioValue.textContent = mixingRatio.value + '%';
+            refresh(false);
+        }
+
+        // Send the new state over the live connection, or fall back to
+        // plain HTTP requests while it is not open
+        function refresh(colorsChanged) {
+            if (colorsChanged) {
+                linearGradient.style.background = 'linear-gradient(to right, ' + color1Input.value + ', ' + color2Input.value + ')';
+            }
+            if (socket) {
+                sendState();
+                return;
+            }
             updateMixing();
+            if (colorsChanged) {
+                updateGradients();
+            }
+        }
+
+        // Live mixing over a WebSocket. The server only computes the newest
+        // state it has received, so every input event can be sent.
+        let socket = null;
+        let liveSeq = 0;
+        function connectLive() {
+            const ws = new WebSocket((location.protocol === 'https:' ? 'wss://' : 'ws://') + location.host + '/live');
+            ws.onopen = function () {
+                socket = ws;
+                sendState();
+            };
+            ws.onmessage = function (event) {
+                showLive(JSON.parse(event.data));
+            };
+            ws.onclose = function () {
+                if (socket === ws) {
+                    socket = null;
+                }
+                setTimeout(connectLive, 2000);
+            };
+        }
+
+        function sendState() {
+            socket.send(JSON.stringify({
+                seq: ++liveSeq,
+                colors: [color1Input.value, color2Input.value],
+                ratio: mixingRatio.value / 100,
+                stops: 11
+            }));
+        }
+
+        function showLive(result) {
+            if (result.error) {
+                console.error('Error mixing live:', result.error);
+                return;
+            }
+            mixboxResult.style.backgroundColor = result.color;
+            linearResult.style.backgroundColor = result.linearColor;
+            mixboxHex.textContent = result.color;
+            linearHex.textContent = result.linearColor;
+            mixboxGradient.style.background = result.css;
         }
 
         // Fetch mixed colors from the server
@@ -192,7 +231,7 @@ func main() {
             const ratio = mixingRatio.value / 100;
 
             try {
-                const response = await fetch(`/mix?color1=${encodeURIComponent(color1)}&color2=${encodeURIComponent(color2)}&ratio=${ratio}`);
+                const response = await fetch('/mix?color1=' + encodeURIComponent(color1) + '&color2=' + encodeURIComponent(color2) + '&ratio=' + ratio);
                 const result = await response.json();
                 
                 // Update result displays
@@ -200,53 +239,41 @@ func main() {
                 linearResult.style.backgroundColor = result.linearColor;
                 mixboxHex.textContent = result.mixedColor;
                 linearHex.textContent = result.linearColor;
-                
-                // Update gradients
-                updateGradients(color1, color2);
             } catch (error) {
                 console.error('Error fetching color mix:', error);
             }
         }
 
-        // Create gradient displays
-        function updateGradients(color1, color2) {
-            linearGradient.style.background = `linear-gradient(to right, ${color1}, ${color2})`;
-            
-            // For the mixbox gradient, we'll use a series of 10 color stops
-            let mixboxGradientStops = [];
-            for (let i = 0; i <= 10; i++) {
-                let ratio = i / 10;
-                fetch(`/mix?color1=${encodeURIComponent(color1)}&color2=${encodeURIComponent(color2)}&ratio=${ratio}`)
-                    .then(response => response.json())
-                    .then(result => {
-                        mixboxGradientStops.push({ ratio: ratio, color: result.mixedColor });
-                        if (mixboxGradientStops.length === 11) {
-                            // Sort by ratio
-                            mixboxGradientStops.sort((a, b) => a.ratio - b.ratio);
-                            
-                            // Build gradient string
-                            let gradientStr = 'linear-gradient(to right';
-                            for (const stop of mixboxGradientStops) {
-                                gradientStr += `, ${stop.color} ${stop.ratio * 100}%`;
-                            }
-                            gradientStr += ')';
-                            
-                            mixboxGradient.style.background = gradientStr;
-                        }
-                    });
+        // Fetch the whole mixbox gradient in one request. Responses to
+        // earlier input events are dropped if a newer request was made.
+        let gradientRequest = 0;
+        async function updateGradients() {
+            const color1 = color1Input.value;
+            const color2 = color2Input.value;
+            const id = ++gradientRequest;
+            const params = new URLSearchParams([['color', color1], ['color', color2], ['stops', '11']]);
+            try {
+                const response = await fetch('/gradient?' + params);
+                const result = await response.json();
+                if (id === gradientRequest) {
+                    mixboxGradient.style.background = result.css;
+                }
+            } catch (error) {
+                console.error('Error fetching gradient:', error);
             }
         }
 
         // Event listeners
         color1Input.addEventListener('input', updateColor1);
-        color2Input.addEventListener('change', updateColor1);
+        color1Input.addEventListener('change', updateColor1);
         color2Input.addEventListener('input', updateColor2);
         color2Input.addEventListener('change', updateColor2);
         mixingRatio.addEventListener('input', updateRatio);
         mixingRatio.addEventListener('change', updateRatio);
 
         // Initialize
-        updateMixing();
+        refresh(true);
+        connectLive();
     </script>
 </body>
 </html>
@@ -258,12 +285,21 @@ func main() {
 		log.Fatalf("Failed to parse template: %v", err)
 	}
 
+	// Configuration: defaults, then MIXBOX_* environment variables, then flags
+	cfg := server.DefaultConfig()
+	if err := cfg.LoadEnv(); err != nil {
+		log.Fatal(err)
+	}
+	cfg.RegisterFlags(flag.CommandLine)
+	flag.Parse()
+
 	// Define HTTP handlers
-	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		tmpl.Execute(w, nil)
 	})
 
-	http.HandleFunc("/mix", func(w http.ResponseWriter, r *http.Request) {
+	mux.HandleFunc("/mix", func(w http.ResponseWriter, r *http.Request) {
 		// Get parameters from the request
 		color1Hex := r.URL.Query().Get("color1")
 		color2Hex := r.URL.Query().Get("color2")
@@ -275,16 +311,16 @@ func main() {
 			return
 		}
 
-		// Convert hex colors to RGB
-		color1, err := hexToRGB(color1Hex)
+		// Parse the colors; any CSS syntax is accepted
+		color1, err := colorparse.ParseRGB(color1Hex)
 		if err != nil {
-			http.Error(w, "Invalid color1", http.StatusBadRequest)
+			http.Error(w, "Invalid color1: "+err.Error(), http.StatusBadRequest)
 			return
 		}
 
-		color2, err := hexToRGB(color2Hex)
+		color2, err := colorparse.ParseRGB(color2Hex)
 		if err != nil {
-			http.Error(w, "Invalid color2", http.StatusBadRequest)
+			http.Error(w, "Invalid color2: "+err.Error(), http.StatusBadRequest)
 			return
 		}
 
@@ -315,7 +351,59 @@ func main() {
 		json.NewEncoder(w).Encode(result)
 	})
 
-	// Start HTTP server
-	fmt.Println("Starting Mixbox demo server on http://localhost:8080")
-	log.Fatal(http.ListenAndServe(":8080", nil))
+	// Versioned JSON API, described at /api/v1/openapi.json
+	apiHandler, err := api.New()
+	if err != nil {
+		log.Fatalf("Failed to create API: %v", err)
+	}
+	mux.Handle(api.Prefix+"/", apiHandler)
+
+	// Live mixing over a WebSocket, used by the page when it can connect
+	liveHandler, err := live.New()
+	if err != nil {
+		log.Fatalf("Failed to create live handler: %v", err)
+	}
+	mux.Handle("/live", liveHandler)
+
+	// Gradients and swatches as PNG and SVG images, for pages without
+	// JavaScript
+	renderHandler, err := render.New()
+	if err != nil {
+		log.Fatalf("Failed to create image renderer: %v", err)
+	}
+	mux.Handle(render.Prefix+"/", renderHandler)
+
+	// /gradient?color=c1&color=c2[&color=...][&stops=n] returns evenly
+	// spaced stops of the pigment gradient and its CSS; it is the
+	// renderer's gradient.json under a shorter name
+	mux.HandleFunc("GET /gradient", func(w http.ResponseWriter, r *http.Request) {
+		r = r.Clone(r.Context())
+		r.URL.Path = render.Prefix + "/gradient.json"
+		renderHandler.ServeHTTP(w, r)
+	})
+
+	// Serve with timeouts and health checks until SIGINT or SIGTERM, then
+	// let requests in flight finish
+	srv, err := server.New(cfg, mux)
+	if err != nil {
+		log.Fatalf("Failed to configure server: %v", err)
+	}
+	srv.RegisterOnShutdown(liveHandler.Shutdown)
+
+	// Reread the lookup table and palette files on SIGHUP
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		for range hup {
+			srv.Reload()
+		}
+	}()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	fmt.Println("Starting Mixbox demo server on " + cfg.Addr)
+	if err := srv.Run(ctx); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println("Server stopped")
 }
\ No newline at end of file
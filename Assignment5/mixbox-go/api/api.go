@@ -0,0 +1,239 @@
+// Package api serves version 1 of the Mixbox JSON API: two-color and
+// weighted mixes, gradients, recipes and pigment lookups.
+//
+// Requests with a body are POSTs of a JSON object. Errors are RFC 7807
+// application/problem+json documents; a body that decodes but fails
+// validation gets status 422 with one entry per invalid field. The API is
+// described by a hand-written OpenAPI document served at
+// /api/v1/openapi.json; CheckSpec compares it with the handlers.
+//
+// Wherever a color is expected, any CSS color syntax is accepted, as are
+// the names and Color Index codes of the pigments in the handler's palette.
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/timf34/mixbox-go/internal/mixing"
+	"github.com/timf34/mixbox-go/mixbox"
+	"github.com/timf34/mixbox-go/pigments"
+)
+
+// Prefix is the path under which the API is served.
+const Prefix = "/api/v1"
+
+// Limits on requests.
+const (
+	// DefaultMaxBodyBytes is the default limit on the size of a request
+	// body; see WithMaxBodyBytes.
+	DefaultMaxBodyBytes = 64 << 10
+	// MaxColors is the most colors a mix or gradient may have.
+	MaxColors = mixing.MaxColors
+	// MaxStops is the most stops a gradient may be sampled at.
+	MaxStops = mixing.MaxStops
+	// MaxRecipePalette is the most pigments a recipe may choose from, and
+	// MaxRecipePigments the most it may use.
+	MaxRecipePalette  = 32
+	MaxRecipePigments = 6
+)
+
+// Handler serves the API. It is safe for concurrent use.
+type Handler struct {
+	src          mixing.Source
+	maxBodyBytes int64
+	mux          *http.ServeMux
+}
+
+// Option configures a Handler.
+type Option func(*Handler) error
+
+// WithMixer mixes with m instead of the default Mixer.
+func WithMixer(m *mixbox.Mixer) Option {
+	return func(h *Handler) error {
+		if err := h.src.SetMixer(m); err != nil {
+			return fmt.Errorf("api: %w", err)
+		}
+		return nil
+	}
+}
+
+// WithPalette serves p instead of the default palette; see
+// pigments.Default.
+func WithPalette(p *pigments.Palette) Option {
+	return func(h *Handler) error {
+		if err := h.src.SetPalette(p); err != nil {
+			return fmt.Errorf("api: %w", err)
+		}
+		return nil
+	}
+}
+
+// WithMaxBodyBytes limits request bodies to n bytes; larger requests fail
+// with status 413.
+func WithMaxBodyBytes(n int64) Option {
+	return func(h *Handler) error {
+		if n < 1 {
+			return fmt.Errorf("api: invalid body limit %d", n)
+		}
+		h.maxBodyBytes = n
+		return nil
+	}
+}
+
+// New returns a Handler for the paths under Prefix.
+func New(opts ...Option) (*Handler, error) {
+	h := &Handler{
+		maxBodyBytes: DefaultMaxBodyBytes,
+		mux:          http.NewServeMux(),
+	}
+	for _, opt := range opts {
+		if err := opt(h); err != nil {
+			return nil, err
+		}
+	}
+
+	byPath := make(map[string][]route)
+	var paths []string
+	for _, rt := range routes {
+		if byPath[rt.path] == nil {
+			paths = append(paths, rt.path)
+		}
+		byPath[rt.path] = append(byPath[rt.path], rt)
+	}
+	for _, path := range paths {
+		h.mux.Handle(Prefix+path, h.dispatch(byPath[path]))
+	}
+	h.mux.HandleFunc(Prefix+"/", func(w http.ResponseWriter, r *http.Request) {
+		writeProblem(w, r, problemf(http.StatusNotFound, "no API endpoint at %s", r.URL.Path))
+	})
+	return h, nil
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// snapshot returns a copy of h bound to the current Mixer and palette, so
+// that a request sees one version of each even if they are replaced while
+// it runs.
+func (h *Handler) snapshot() *Handler {
+	c := *h
+	c.src = h.src.Snapshot()
+	return &c
+}
+
+// route is one operation of the API.
+type route struct {
+	method string
+	// path is relative to Prefix and written as in the OpenAPI document,
+	// which is also the http.ServeMux pattern syntax.
+	path  string
+	serve func(h *Handler, r *http.Request) (any, error)
+	// request and response are zero values of the body types; CheckSpec
+	// compares them with the document's schemas. request is nil for
+	// operations without a body.
+	request, response any
+}
+
+// dispatch returns the handler for the routes sharing a path.
+func (h *Handler) dispatch(rts []route) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var allow []string
+		for _, rt := range rts {
+			if rt.method == r.Method || rt.method == http.MethodGet && r.Method == http.MethodHead {
+				h.serve(w, r, rt)
+				return
+			}
+			allow = append(allow, rt.method)
+		}
+		w.Header().Set("Allow", strings.Join(allow, ", "))
+		writeProblem(w, r, problemf(http.StatusMethodNotAllowed, "%s does not support %s", r.URL.Path, r.Method))
+	})
+}
+
+func (h *Handler) serve(w http.ResponseWriter, r *http.Request, rt route) {
+	r.Body = http.MaxBytesReader(w, r.Body, h.maxBodyBytes)
+	resp, err := rt.serve(h.snapshot(), r)
+	if err != nil {
+		var p *Problem
+		if !errors.As(err, &p) {
+			log.Printf("api: %s %s: %v", r.Method, r.URL.Path, err)
+			p = problemf(http.StatusInternalServerError, "internal error")
+		}
+		writeProblem(w, r, p)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// decode reads the JSON request body into v, rejecting unknown fields and
+// trailing data.
+func decode(r *http.Request, v any) error {
+	if ct := r.Header.Get("Content-Type"); ct != "" {
+		if mt, _, err := mime.ParseMediaType(ct); err != nil || mt != "application/json" {
+			return problemf(http.StatusUnsupportedMediaType, "request body must be application/json, got %q", ct)
+		}
+	}
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	err := dec.Decode(v)
+	if err == nil && dec.More() {
+		err = errors.New("unexpected data after the JSON object")
+	}
+	if err == nil {
+		return nil
+	}
+
+	var (
+		tooLarge *http.MaxBytesError
+		syntax   *json.SyntaxError
+		typ      *json.UnmarshalTypeError
+	)
+	switch {
+	case errors.As(err, &tooLarge):
+		return problemf(http.StatusRequestEntityTooLarge, "request body exceeds %d bytes", tooLarge.Limit)
+	case errors.Is(err, io.EOF):
+		return problemf(http.StatusBadRequest, "request body is empty")
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		return problemf(http.StatusBadRequest, "request body ends in the middle of a JSON value")
+	case errors.As(err, &syntax):
+		return problemf(http.StatusBadRequest, "malformed JSON at byte %d: %v", syntax.Offset, err)
+	case errors.As(err, &typ):
+		var v validation
+		v.addf(typ.Field, "must be %s, got %s", jsonKind(typ.Type.Kind().String()), typ.Value)
+		return v.problem()
+	}
+	if field, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+		var v validation
+		v.addf(strings.Trim(field, `"`), "unknown field")
+		return v.problem()
+	}
+	return problemf(http.StatusBadRequest, "%v", err)
+}
+
+// jsonKind names a Go kind the way JSON would.
+func jsonKind(kind string) string {
+	switch {
+	case kind == "string":
+		return "a string"
+	case kind == "bool":
+		return "a boolean"
+	case kind == "slice" || kind == "array":
+		return "an array"
+	case kind == "struct" || kind == "map":
+		return "an object"
+	case strings.HasPrefix(kind, "float"):
+		return "a number"
+	case strings.HasPrefix(kind, "int") || strings.HasPrefix(kind, "uint"):
+		return "an integer"
+	}
+	return "a " + kind
+}
@@ -0,0 +1,359 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/timf34/mixbox-go/colorparse"
+	"github.com/timf34/mixbox-go/gradient"
+	"github.com/timf34/mixbox-go/internal/mixing"
+	"github.com/timf34/mixbox-go/mixbox"
+	"github.com/timf34/mixbox-go/pigments"
+)
+
+var routes = []route{
+	{http.MethodPost, "/lerp", (*Handler).lerp, lerpRequest{}, colorResponse{}},
+	{http.MethodPost, "/mix", (*Handler).mix, mixRequest{}, colorResponse{}},
+	{http.MethodPost, "/gradient", (*Handler).gradient, gradientRequest{}, gradientResponse{}},
+	{http.MethodPost, "/recipe", (*Handler).recipe, recipeRequest{}, recipeResponse{}},
+	{http.MethodGet, "/pigments", (*Handler).pigments, nil, paletteResponse{}},
+	{http.MethodGet, "/pigments/{key}", (*Handler).pigment, nil, pigmentResponse{}},
+	{http.MethodGet, "/openapi.json", (*Handler).openAPI, nil, json.RawMessage{}},
+}
+
+// colorResponse is a single mixed color.
+type colorResponse struct {
+	Color string   `json:"color"`
+	RGB   [3]uint8 `json:"rgb"`
+}
+
+func newColorResponse(rgb [3]uint8) colorResponse {
+	return colorResponse{colorparse.FromRGB(rgb).Hex(), rgb}
+}
+
+// color resolves a color field, recording a field error if it is invalid.
+func (h *Handler) color(v *validation, field, s string) [3]uint8 {
+	if s == "" {
+		v.addf(field, "is required")
+		return [3]uint8{}
+	}
+	p, err := h.src.Palette().Resolve(s)
+	if err != nil {
+		v.addf(field, "is not a color or pigment: %v", err)
+	}
+	return p.RGB
+}
+
+type lerpRequest struct {
+	Color1 string `json:"color1"`
+	Color2 string `json:"color2"`
+	// Ratio is the share of Color2, 0.5 if omitted.
+	Ratio *float64 `json:"ratio,omitempty"`
+}
+
+func (h *Handler) lerp(r *http.Request) (any, error) {
+	var req lerpRequest
+	if err := decode(r, &req); err != nil {
+		return nil, err
+	}
+	var v validation
+	c1 := h.color(&v, "color1", req.Color1)
+	c2 := h.color(&v, "color2", req.Color2)
+	ratio := 0.5
+	if req.Ratio != nil {
+		ratio = *req.Ratio
+		v.within("ratio", ratio, 0, 1)
+	}
+	if err := v.problem(); err != nil {
+		return nil, err
+	}
+	return newColorResponse(h.src.Mixer().Lerp(c1, c2, ratio)), nil
+}
+
+type mixRequest struct {
+	Colors []weightedColor `json:"colors"`
+}
+
+type weightedColor struct {
+	Color string `json:"color"`
+	// Weight is the relative amount of the color, 1 if omitted.
+	Weight *float64 `json:"weight,omitempty"`
+}
+
+func (h *Handler) mix(r *http.Request) (any, error) {
+	var req mixRequest
+	if err := decode(r, &req); err != nil {
+		return nil, err
+	}
+	var v validation
+	colors := make([]mixbox.Color, len(req.Colors))
+	weights := make([]float64, len(req.Colors))
+	var total float64
+	if v.count("colors", len(req.Colors), 1, MaxColors) {
+		for i, c := range req.Colors {
+			field := fmt.Sprintf("colors[%d]", i)
+			colors[i] = mixbox.RGB(h.color(&v, field+".color", c.Color))
+			weights[i] = 1
+			if c.Weight != nil {
+				weights[i] = *c.Weight
+				if weights[i] < 0 {
+					v.addf(field+".weight", "must not be negative, got %v", weights[i])
+					continue
+				}
+			}
+			total += weights[i]
+		}
+		if total == 0 {
+			v.addf("colors", "weights must not all be zero")
+		}
+		if math.IsInf(total, 0) {
+			v.addf("colors", "weights must sum to at most %v", math.MaxFloat64)
+		}
+	}
+	if err := v.problem(); err != nil {
+		return nil, err
+	}
+	latent, err := h.src.Mixer().Mix(colors, weights)
+	if errors.Is(err, mixbox.ErrWeights) {
+		return nil, problemf(http.StatusUnprocessableEntity, "%v", err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newColorResponse(latent.RGB()), nil
+}
+
+type gradientRequest struct {
+	Colors []string `json:"colors"`
+	// Stops is the number of evenly spaced colors returned, 11 if omitted.
+	Stops int `json:"stops,omitempty"`
+	// Easing is a CSS easing name; see gradient.EasingByName.
+	Easing string `json:"easing,omitempty"`
+	// Direction is the first argument of the CSS linear-gradient, "to
+	// right" if omitted: "to" and a side or corner, or an angle.
+	Direction string `json:"direction,omitempty"`
+}
+
+type gradientStop struct {
+	Position float64 `json:"position"`
+	Color    string  `json:"color"`
+}
+
+type gradientResponse struct {
+	Stops []gradientStop `json:"stops"`
+	// CSS reproduces the pigment gradient as a linear-gradient value.
+	CSS string `json:"css"`
+}
+
+func (h *Handler) gradient(r *http.Request) (any, error) {
+	req := gradientRequest{Stops: mixing.DefaultStops, Easing: "linear", Direction: "to right"}
+	if err := decode(r, &req); err != nil {
+		return nil, err
+	}
+	var v validation
+	var colors [][3]uint8
+	if v.count("colors", len(req.Colors), 2, MaxColors) {
+		for i, s := range req.Colors {
+			colors = append(colors, h.color(&v, fmt.Sprintf("colors[%d]", i), s))
+		}
+	}
+	if req.Stops < 2 || req.Stops > MaxStops {
+		v.addf("stops", "must be between 2 and %d, got %d", MaxStops, req.Stops)
+	}
+	easing, ok := gradient.EasingByName(req.Easing)
+	if !ok {
+		v.addf("easing", "unknown easing %q", req.Easing)
+	}
+	if !validDirection(req.Direction) {
+		v.addf("direction", `must be "to" and a side or corner, such as "to top left", or an angle, such as 90deg, got %q`, req.Direction)
+	}
+	if err := v.problem(); err != nil {
+		return nil, err
+	}
+
+	g, err := gradient.Even(colors, gradient.WithMixer(h.src.Mixer()), gradient.WithEasing(easing))
+	if err != nil {
+		return nil, err
+	}
+	resp := gradientResponse{CSS: g.CSS(req.Direction)}
+	for i, rgb := range g.Sample(req.Stops) {
+		resp.Stops = append(resp.Stops, gradientStop{
+			Position: float64(i) / float64(req.Stops-1),
+			Color:    colorparse.FromRGB(rgb).Hex(),
+		})
+	}
+	return resp, nil
+}
+
+// validDirection reports whether s is a CSS linear-gradient direction:
+// "to" followed by a side or by a horizontal and a vertical side in either
+// order, or an angle in deg, grad, rad or turn. The direction is copied
+// into the CSS the handler returns, so anything else is rejected.
+func validDirection(s string) bool {
+	words := strings.Fields(strings.ToLower(s))
+	switch {
+	case len(words) == 1:
+		return validAngle(words[0])
+	case len(words) == 2 && words[0] == "to":
+		return side(words[1]) != 0
+	case len(words) == 3 && words[0] == "to":
+		a, b := side(words[1]), side(words[2])
+		return a != 0 && b != 0 && a != b
+	}
+	return false
+}
+
+// side returns 'h' for left and right, 'v' for top and bottom and 0 for
+// anything else.
+func side(word string) byte {
+	switch word {
+	case "left", "right":
+		return 'h'
+	case "top", "bottom":
+		return 'v'
+	}
+	return 0
+}
+
+// validAngle reports whether s is a CSS angle. A plain 0 is allowed, as in
+// CSS.
+func validAngle(s string) bool {
+	if s == "0" {
+		return true
+	}
+	for _, unit := range []string{"deg", "grad", "rad", "turn"} {
+		num, ok := strings.CutSuffix(s, unit)
+		if !ok || num == "" {
+			continue
+		}
+		// ParseFloat also accepts hex, underscores, inf and nan, which CSS
+		// does not.
+		if strings.Trim(num, "0123456789.+-e") != "" {
+			return false
+		}
+		_, err := strconv.ParseFloat(num, 64)
+		return err == nil
+	}
+	return false
+}
+
+type recipeRequest struct {
+	Target string `json:"target"`
+	// Palette lists the pigments to choose from, by name, code or color;
+	// the handler's palette if omitted.
+	Palette []string `json:"palette,omitempty"`
+	// MaxPigments is the most pigments the recipe may use, 3 if omitted.
+	MaxPigments int `json:"maxPigments,omitempty"`
+}
+
+type ingredient struct {
+	Name   string  `json:"name"`
+	Code   string  `json:"code,omitempty"`
+	Color  string  `json:"color"`
+	Weight float64 `json:"weight"`
+}
+
+type recipeResponse struct {
+	Target string `json:"target"`
+	Color  string `json:"color"`
+	// DeltaE is the CIEDE2000 difference between the mix and the target.
+	DeltaE      float64      `json:"deltaE"`
+	Ingredients []ingredient `json:"ingredients"`
+}
+
+func (h *Handler) recipe(r *http.Request) (any, error) {
+	req := recipeRequest{MaxPigments: 3}
+	if err := decode(r, &req); err != nil {
+		return nil, err
+	}
+	var v validation
+	target := h.color(&v, "target", req.Target)
+	palette := h.src.Palette().Pigments()
+	if req.Palette != nil && v.count("palette", len(req.Palette), 1, MaxRecipePalette) {
+		palette = palette[:0:0]
+		for i, s := range req.Palette {
+			p, err := h.src.Palette().Resolve(s)
+			if err != nil {
+				v.addf(fmt.Sprintf("palette[%d]", i), "is not a color or pigment: %v", err)
+			}
+			palette = append(palette, p)
+		}
+	}
+	if len(palette) > MaxRecipePalette {
+		return nil, problemf(http.StatusUnprocessableEntity, "the palette has %d pigments, more than the %d a recipe can use; pass a shorter palette", len(palette), MaxRecipePalette)
+	}
+	if req.MaxPigments < 1 || req.MaxPigments > MaxRecipePigments {
+		v.addf("maxPigments", "must be between 1 and %d, got %d", MaxRecipePigments, req.MaxPigments)
+	}
+	if err := v.problem(); err != nil {
+		return nil, err
+	}
+
+	colors := make([][3]uint8, len(palette))
+	for i, p := range palette {
+		colors[i] = p.RGB
+	}
+	rec, err := h.src.Mixer().SolveRecipe(target, colors, req.MaxPigments)
+	if err != nil {
+		return nil, err
+	}
+	resp := recipeResponse{
+		Target:      colorparse.FromRGB(target).Hex(),
+		Color:       colorparse.FromRGB(rec.RGB).Hex(),
+		DeltaE:      mixbox.DeltaE2000(mixbox.RGBToLab(target), mixbox.RGBToLab(rec.RGB)),
+		Ingredients: []ingredient{},
+	}
+	for i, w := range rec.Weights {
+		if w > 0 {
+			p := palette[i]
+			resp.Ingredients = append(resp.Ingredients, ingredient{p.Name, p.Code, colorparse.FromRGB(p.RGB).Hex(), w})
+		}
+	}
+	sort.SliceStable(resp.Ingredients, func(a, b int) bool { return resp.Ingredients[a].Weight > resp.Ingredients[b].Weight })
+	return resp, nil
+}
+
+type pigmentResponse struct {
+	Name     string   `json:"name"`
+	Code     string   `json:"code,omitempty"`
+	Color    string   `json:"color"`
+	RGB      [3]uint8 `json:"rgb"`
+	Opacity  string   `json:"opacity"`
+	Staining string   `json:"staining"`
+}
+
+func newPigmentResponse(p pigments.Pigment) pigmentResponse {
+	return pigmentResponse{p.Name, p.Code, colorparse.FromRGB(p.RGB).Hex(), p.RGB, p.Opacity.String(), p.Staining.String()}
+}
+
+type paletteResponse struct {
+	Name     string            `json:"name"`
+	Pigments []pigmentResponse `json:"pigments"`
+}
+
+func (h *Handler) pigments(r *http.Request) (any, error) {
+	resp := paletteResponse{Name: h.src.Palette().Name(), Pigments: []pigmentResponse{}}
+	for _, p := range h.src.Palette().Pigments() {
+		resp.Pigments = append(resp.Pigments, newPigmentResponse(p))
+	}
+	return resp, nil
+}
+
+func (h *Handler) pigment(r *http.Request) (any, error) {
+	key := r.PathValue("key")
+	p, ok := h.src.Palette().Lookup(key)
+	if !ok {
+		return nil, problemf(http.StatusNotFound, "no pigment named or coded %q in palette %q", key, h.src.Palette().Name())
+	}
+	return newPigmentResponse(p), nil
+}
+
+func (h *Handler) openAPI(r *http.Request) (any, error) {
+	return json.RawMessage(openAPIDocument), nil
+}
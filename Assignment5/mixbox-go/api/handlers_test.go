@@ -0,0 +1,256 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/timf34/mixbox-go/mixbox"
+)
+
+// testHandler returns a Handler on the embedded table, skipping the test
+// when the mixbox package was built without one.
+func testHandler(t *testing.T, opts ...Option) *Handler {
+	t.Helper()
+	if _, ok := mixbox.DefaultOK(); !ok {
+		t.Skip("built without an embedded LUT")
+	}
+	h, err := New(opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+// do sends a request to h; a non-empty body is sent as JSON.
+func do(h http.Handler, method, path, body string) *httptest.ResponseRecorder {
+	var r *http.Request
+	if body == "" {
+		r = httptest.NewRequest(method, path, nil)
+	} else {
+		r = httptest.NewRequest(method, path, strings.NewReader(body))
+		r.Header.Set("Content-Type", "application/json")
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w
+}
+
+// problem decodes a problem+json response and checks the fields every
+// problem carries.
+func problem(t *testing.T, w *httptest.ResponseRecorder, path string, status int) Problem {
+	t.Helper()
+	if w.Code != status {
+		t.Fatalf("status %d, want %d; body %s", w.Code, status, w.Body)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/problem+json" {
+		t.Errorf("Content-Type %q, want application/problem+json", ct)
+	}
+	var p Problem
+	dec := json.NewDecoder(w.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&p); err != nil {
+		t.Fatalf("decoding problem: %v", err)
+	}
+	if p.Type != "about:blank" || p.Title != http.StatusText(status) || p.Status != status || p.Instance != path {
+		t.Errorf("problem %+v, want type about:blank, title %q, status %d and instance %s", p, http.StatusText(status), status, path)
+	}
+	return p
+}
+
+func TestLerp(t *testing.T) {
+	h := testHandler(t)
+	w := do(h, http.MethodPost, Prefix+"/lerp", `{"color1": "#feec00", "color2": "PB29", "ratio": 0.25}`)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status %d: %s", w.Code, w.Body)
+	}
+	var resp colorResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if want := mixbox.Default().Lerp([3]uint8{254, 236, 0}, [3]uint8{25, 0, 89}, 0.25); resp.RGB != want {
+		t.Errorf("rgb %v, want %v", resp.RGB, want)
+	}
+}
+
+func TestProblemDetails(t *testing.T) {
+	h := testHandler(t)
+	tests := []struct {
+		name, path, body string
+		status           int
+		detail           string
+	}{
+		{"malformed", "/lerp", `{"color1": `, http.StatusBadRequest, "ends in the middle"},
+		{"syntax", "/lerp", `{"color1" "red"}`, http.StatusBadRequest, "malformed JSON"},
+		{"trailing data", "/lerp", `{"color1": "red", "color2": "blue"} {}`, http.StatusBadRequest, "unexpected data"},
+		{"unknown pigment", "/pigments/PX99", "", http.StatusNotFound, `"PX99"`},
+		{"unknown endpoint", "/nowhere", "", http.StatusNotFound, "no API endpoint"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			method := http.MethodPost
+			if tt.body == "" {
+				method = http.MethodGet
+			}
+			p := problem(t, do(h, method, Prefix+tt.path, tt.body), Prefix+tt.path, tt.status)
+			if !strings.Contains(p.Detail, tt.detail) {
+				t.Errorf("detail %q, want it to contain %q", p.Detail, tt.detail)
+			}
+		})
+	}
+}
+
+func TestEmptyBody(t *testing.T) {
+	h := testHandler(t)
+	r := httptest.NewRequest(http.MethodPost, Prefix+"/lerp", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	problem(t, w, Prefix+"/lerp", http.StatusBadRequest)
+}
+
+func TestValidationErrors(t *testing.T) {
+	h := testHandler(t)
+	tests := []struct {
+		name, path, body string
+		fields           []string
+	}{
+		{"lerp", "/lerp", `{"color1": "not a color", "ratio": 2}`, []string{"color1", "color2", "ratio"}},
+		{"wrong type", "/lerp", `{"color1": "red", "color2": "blue", "ratio": "half"}`, []string{"ratio"}},
+		{"unknown field", "/lerp", `{"color1": "red", "color2": "blue", "colour": "green"}`, []string{"colour"}},
+		{"no colors", "/mix", `{"colors": []}`, []string{"colors"}},
+		{"negative weight", "/mix", `{"colors": [{"color": "red", "weight": -1}, {"color": "blue"}]}`, []string{"colors[0].weight"}},
+		{"zero weights", "/mix", `{"colors": [{"color": "red", "weight": 0}, {"color": "bleu", "weight": 0}]}`, []string{"colors[1].color", "colors"}},
+		{"weight overflow", "/mix", `{"colors": [{"color": "red", "weight": 1e308}, {"color": "blue", "weight": 1e308}]}`, []string{"colors"}},
+		{"gradient", "/gradient", `{"colors": ["red"], "stops": 1, "easing": "bouncy"}`, []string{"colors", "stops", "easing"}},
+		{"recipe", "/recipe", `{"target": "", "palette": ["PY35", "nope"], "maxPigments": 7}`, []string{"target", "palette[1]", "maxPigments"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := problem(t, do(h, http.MethodPost, Prefix+tt.path, tt.body), Prefix+tt.path, http.StatusUnprocessableEntity)
+			var fields []string
+			for _, e := range p.Errors {
+				if e.Message == "" {
+					t.Errorf("field %s has no message", e.Field)
+				}
+				fields = append(fields, e.Field)
+			}
+			if !slices.Equal(fields, tt.fields) {
+				t.Errorf("invalid fields %q, want %q", fields, tt.fields)
+			}
+		})
+	}
+}
+
+func TestUnsupportedMediaType(t *testing.T) {
+	h := testHandler(t)
+	r := httptest.NewRequest(http.MethodPost, Prefix+"/lerp", strings.NewReader(`{"color1": "red", "color2": "blue"}`))
+	r.Header.Set("Content-Type", "text/plain")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	p := problem(t, w, Prefix+"/lerp", http.StatusUnsupportedMediaType)
+	if !strings.Contains(p.Detail, "text/plain") {
+		t.Errorf("detail %q does not name the media type", p.Detail)
+	}
+}
+
+func TestMethodNotAllowed(t *testing.T) {
+	h := testHandler(t)
+	for _, tt := range []struct{ method, path, allow string }{
+		{http.MethodGet, "/lerp", "POST"},
+		{http.MethodPut, "/mix", "POST"},
+		{http.MethodPost, "/pigments", "GET"},
+		{http.MethodDelete, "/pigments/PY35", "GET"},
+	} {
+		w := do(h, tt.method, Prefix+tt.path, "")
+		problem(t, w, Prefix+tt.path, http.StatusMethodNotAllowed)
+		if got := w.Header().Get("Allow"); got != tt.allow {
+			t.Errorf("%s %s: Allow %q, want %q", tt.method, tt.path, got, tt.allow)
+		}
+	}
+}
+
+func TestBodyTooLarge(t *testing.T) {
+	h := testHandler(t, WithMaxBodyBytes(16))
+	problem(t, do(h, http.MethodPost, Prefix+"/lerp", `{"color1": "red", "color2": "blue"}`), Prefix+"/lerp", http.StatusRequestEntityTooLarge)
+}
+
+func TestGradient(t *testing.T) {
+	h := testHandler(t)
+	w := do(h, http.MethodPost, Prefix+"/gradient", `{"colors": ["#feec00", "PB29"], "stops": 3, "direction": "to top left"}`)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status %d: %s", w.Code, w.Body)
+	}
+	var resp gradientResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Stops) != 3 || resp.Stops[0].Color != "#feec00" || resp.Stops[2].Color != "#190059" || resp.Stops[1].Position != 0.5 {
+		t.Errorf("stops %+v", resp.Stops)
+	}
+	if !strings.HasPrefix(resp.CSS, "linear-gradient(to top left, #feec00 0%, ") {
+		t.Errorf("css %s", resp.CSS)
+	}
+}
+
+func TestGradientDirection(t *testing.T) {
+	h := testHandler(t)
+	for _, tt := range []struct {
+		direction string
+		ok        bool
+	}{
+		{"to right", true},
+		{"to bottom", true},
+		{"To  Top\tLeft", true},
+		{"to left bottom", true},
+		{"90deg", true},
+		{"-0.25turn", true},
+		{"100grad", true},
+		{"1.5rad", true},
+		{"1e2deg", true},
+		{"0", true},
+		{"   ", false},
+		{"to", false},
+		{"to middle", false},
+		{"to left right", false},
+		{"to top bottom", false},
+		{"to top left right", false},
+		{"right", false},
+		{"90", false},
+		{"deg", false},
+		{"90px", false},
+		{"0x10deg", false},
+		{"infdeg", false},
+		{"1_0deg", false},
+		{"red) , url(x", false},
+		{"to right, red 0%, url(x)", false},
+		{"90deg;background:url(x)", false},
+		{"to right</style>", false},
+	} {
+		body, err := json.Marshal(gradientRequest{Colors: []string{"red", "blue"}, Stops: 2, Direction: tt.direction})
+		if err != nil {
+			t.Fatal(err)
+		}
+		w := do(h, http.MethodPost, Prefix+"/gradient", string(body))
+		if !tt.ok {
+			p := problem(t, w, Prefix+"/gradient", http.StatusUnprocessableEntity)
+			if len(p.Errors) != 1 || p.Errors[0].Field != "direction" {
+				t.Errorf("direction %q: errors %+v", tt.direction, p.Errors)
+			}
+			continue
+		}
+		if w.Code != http.StatusOK {
+			t.Errorf("direction %q: status %d: %s", tt.direction, w.Code, w.Body)
+			continue
+		}
+		var resp gradientResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(resp.CSS, "linear-gradient("+tt.direction+", ") {
+			t.Errorf("direction %q: css %s", tt.direction, resp.CSS)
+		}
+	}
+}
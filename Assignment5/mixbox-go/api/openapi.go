@@ -0,0 +1,251 @@
+package api
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// openAPIDocument is the hand-written description of the API served at
+// /api/v1/openapi.json.
+//
+//go:embed openapi.json
+var openAPIDocument []byte
+
+// CheckSpec checks that the OpenAPI document describes exactly the
+// operations the handlers serve, and that its request and response
+// schemas name the same JSON fields, with the same types and the same
+// required fields, as the Go types the handlers decode and encode. A field
+// is required if and only if it is not tagged omitempty.
+func CheckSpec() error {
+	var doc map[string]any
+	if err := json.Unmarshal(openAPIDocument, &doc); err != nil {
+		return fmt.Errorf("api: OpenAPI document: %w", err)
+	}
+	c := specChecker{doc: doc}
+	c.check()
+	if len(c.errs) > 0 {
+		return fmt.Errorf("api: OpenAPI document does not match the handlers:\n\t%s", strings.Join(c.errs, "\n\t"))
+	}
+	return nil
+}
+
+type specChecker struct {
+	doc  map[string]any
+	errs []string
+}
+
+func (c *specChecker) errorf(format string, args ...any) {
+	c.errs = append(c.errs, fmt.Sprintf(format, args...))
+}
+
+func (c *specChecker) check() {
+	paths, _ := c.doc["paths"].(map[string]any)
+	documented := make(map[string]bool)
+	for path, item := range paths {
+		ops, _ := item.(map[string]any)
+		for method := range ops {
+			switch method {
+			case "get", "put", "post", "delete", "patch", "head", "options":
+				documented[strings.ToUpper(method)+" "+path] = true
+			}
+		}
+	}
+
+	for _, rt := range routes {
+		key := rt.method + " " + rt.path
+		if !documented[key] {
+			c.errorf("%s is served but not documented", key)
+			continue
+		}
+		delete(documented, key)
+		op := paths[rt.path].(map[string]any)[strings.ToLower(rt.method)].(map[string]any)
+		c.checkParams(key, rt.path, op)
+
+		body := c.lookup(op, "requestBody", "content", "application/json", "schema")
+		switch {
+		case rt.request == nil && body != nil:
+			c.errorf("%s: documents a request body the handler does not read", key)
+		case rt.request != nil && body == nil:
+			c.errorf("%s: request body is not documented", key)
+		case rt.request != nil:
+			c.checkSchema(key+" request", body, reflect.TypeOf(rt.request))
+		}
+
+		if resp := c.lookup(op, "responses", "200", "content", "application/json", "schema"); resp == nil {
+			c.errorf("%s: 200 response is not documented", key)
+		} else {
+			c.checkSchema(key+" response", resp, reflect.TypeOf(rt.response))
+		}
+	}
+
+	var extra []string
+	for key := range documented {
+		extra = append(extra, key)
+	}
+	sort.Strings(extra)
+	for _, key := range extra {
+		c.errorf("%s is documented but not served", key)
+	}
+
+	if problem := c.lookup(c.doc, "components", "schemas", "Problem"); problem == nil {
+		c.errorf("the Problem schema is missing")
+	} else {
+		c.checkSchema("Problem", problem, reflect.TypeOf(Problem{}))
+	}
+}
+
+// checkParams checks that every wildcard in path is a documented path
+// parameter.
+func (c *specChecker) checkParams(key, path string, op map[string]any) {
+	params, _ := op["parameters"].([]any)
+	for _, seg := range strings.Split(path, "/") {
+		name, ok := strings.CutPrefix(seg, "{")
+		if !ok {
+			continue
+		}
+		name = strings.TrimSuffix(name, "}")
+		found := false
+		for _, p := range params {
+			p := c.resolve(p)
+			if p != nil && p["name"] == name && p["in"] == "path" {
+				found = true
+			}
+		}
+		if !found {
+			c.errorf("%s: path parameter %q is not documented", key, name)
+		}
+	}
+}
+
+// lookup follows keys from m, resolving $refs, and returns nil if any is
+// missing.
+func (c *specChecker) lookup(m map[string]any, keys ...string) map[string]any {
+	for _, k := range keys {
+		if m = c.resolve(m[k]); m == nil {
+			return nil
+		}
+	}
+	return m
+}
+
+// resolve returns v as an object, following a local $ref.
+func (c *specChecker) resolve(v any) map[string]any {
+	m, _ := v.(map[string]any)
+	for m != nil {
+		ref, ok := m["$ref"].(string)
+		if !ok {
+			return m
+		}
+		path, ok := strings.CutPrefix(ref, "#/")
+		if !ok {
+			c.errorf("unsupported $ref %q", ref)
+			return nil
+		}
+		m = c.doc
+		for _, k := range strings.Split(path, "/") {
+			m, _ = m[k].(map[string]any)
+		}
+		if m == nil {
+			c.errorf("dangling $ref %q", ref)
+		}
+	}
+	return m
+}
+
+var rawMessageType = reflect.TypeOf(json.RawMessage(nil))
+
+// checkSchema compares a schema with the Go type encoded or decoded under
+// it.
+func (c *specChecker) checkSchema(where string, schema map[string]any, t reflect.Type) {
+	if t == rawMessageType {
+		return
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	typ, _ := schema["type"].(string)
+	want := ""
+	switch t.Kind() {
+	case reflect.String:
+		want = "string"
+	case reflect.Bool:
+		want = "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		want = "integer"
+	case reflect.Float32, reflect.Float64:
+		want = "number"
+	case reflect.Slice, reflect.Array:
+		want = "array"
+	case reflect.Struct:
+		want = "object"
+	default:
+		c.errorf("%s: no schema mapping for Go type %s", where, t)
+		return
+	}
+	if typ != want {
+		c.errorf("%s: type is %q, the handler uses %s (%s)", where, typ, want, t)
+		return
+	}
+
+	switch want {
+	case "array":
+		if items := c.resolve(schema["items"]); items == nil {
+			c.errorf("%s: array has no items schema", where)
+		} else {
+			c.checkSchema(where+"[]", items, t.Elem())
+		}
+	case "object":
+		c.checkObject(where, schema, t)
+	}
+}
+
+func (c *specChecker) checkObject(where string, schema map[string]any, t reflect.Type) {
+	props, _ := schema["properties"].(map[string]any)
+	required := make(map[string]bool)
+	if list, ok := schema["required"].([]any); ok {
+		for _, name := range list {
+			if s, ok := name.(string); ok {
+				required[s] = true
+			}
+		}
+	}
+
+	seen := make(map[string]bool)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		seen[name] = true
+		field := where + "." + name
+		prop := c.resolve(props[name])
+		if prop == nil {
+			c.errorf("%s is not documented", field)
+			continue
+		}
+		optional := strings.Contains(","+opts+",", ",omitempty,")
+		if optional && required[name] {
+			c.errorf("%s is documented as required but is optional", field)
+		} else if !optional && !required[name] {
+			c.errorf("%s is not documented as required", field)
+		}
+		c.checkSchema(field, prop, f.Type)
+	}
+	for name := range props {
+		if !seen[name] {
+			c.errorf("%s.%s is documented but the handler has no such field", where, name)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestCheckSpec(t *testing.T) {
+	if err := CheckSpec(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCheckSpecDetectsDrift(t *testing.T) {
+	defer func(doc []byte) { openAPIDocument = doc }(openAPIDocument)
+	for _, tt := range []struct{ old, new, want string }{
+		{`"/lerp"`, `"/blend"`, "POST /lerp is served but not documented"},
+		{`"ratio"`, `"share"`, "ratio"},
+	} {
+		openAPIDocument = bytes.Replace(defaultDocument, []byte(tt.old), []byte(tt.new), 1)
+		err := CheckSpec()
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("after replacing %s with %s: err = %v, want it to mention %q", tt.old, tt.new, err, tt.want)
+		}
+	}
+}
+
+var defaultDocument = openAPIDocument
+
+func TestOpenAPIServed(t *testing.T) {
+	h := testHandler(t)
+	w := do(h, http.MethodGet, Prefix+"/openapi.json", "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status %d", w.Code)
+	}
+	var served, embedded any
+	if err := json.Unmarshal(w.Body.Bytes(), &served); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(openAPIDocument, &embedded); err != nil {
+		t.Fatal(err)
+	}
+	a, _ := json.Marshal(served)
+	b, _ := json.Marshal(embedded)
+	if !bytes.Equal(a, b) {
+		t.Error("served document differs from the embedded one")
+	}
+}
@@ -0,0 +1,94 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Problem is an RFC 7807 problem details object, the body of every error
+// response. It implements error so handlers can return it.
+type Problem struct {
+	// Type is always "about:blank": the status code says what went wrong
+	// and Title is its standard text.
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	// Errors lists the invalid fields of a request body, for status 422.
+	Errors []FieldError `json:"errors,omitempty"`
+}
+
+// FieldError describes one invalid field of a request body. Field is a
+// path such as "colors[2].weight".
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+func (p *Problem) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "api: %d %s", p.Status, p.Title)
+	if p.Detail != "" {
+		b.WriteString(": " + p.Detail)
+	}
+	for _, e := range p.Errors {
+		fmt.Fprintf(&b, "; %s: %s", e.Field, e.Message)
+	}
+	return b.String()
+}
+
+func problemf(status int, format string, args ...any) *Problem {
+	return &Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: fmt.Sprintf(format, args...),
+	}
+}
+
+func writeProblem(w http.ResponseWriter, r *http.Request, p *Problem) {
+	if p.Instance == "" {
+		p.Instance = r.URL.Path
+	}
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(p.Status)
+	json.NewEncoder(w).Encode(p)
+}
+
+// validation collects the field errors of a request body.
+type validation struct {
+	errs []FieldError
+}
+
+func (v *validation) addf(field, format string, args ...any) {
+	v.errs = append(v.errs, FieldError{field, fmt.Sprintf(format, args...)})
+}
+
+// problem returns nil if no field was invalid.
+func (v *validation) problem() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	p := problemf(http.StatusUnprocessableEntity, "the request body failed validation")
+	p.Errors = v.errs
+	return p
+}
+
+// count checks that a list field has between lo and hi entries.
+func (v *validation) count(field string, n, lo, hi int) bool {
+	if n < lo || n > hi {
+		v.addf(field, "must have %d to %d entries, got %d", lo, hi, n)
+		return false
+	}
+	return true
+}
+
+// within checks that a number field lies in lo..hi.
+func (v *validation) within(field string, x, lo, hi float64) {
+	if !(x >= lo && x <= hi) {
+		v.addf(field, "must be between %v and %v, got %v", lo, hi, x)
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/timf34/mixbox-go/mixbox"
+)
+
+func runCache(args []string) error {
+	fs := flag.NewFlagSet("cache", flag.ContinueOnError)
+	out := fs.String("o", "mixbox.cache", "output file")
+	var global globalFlags
+	fs.StringVar(&global.lut, "lut", "", "build the cache for the lookup table in this file instead of the built-in one")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: mixbox cache [-lut file] [-o file]")
+		fmt.Fprintln(fs.Output(), "Precomputes the latent of every 8-bit color for use with WithLatentCache.")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		fs.Usage()
+		return errors.New("unexpected arguments")
+	}
+
+	m, err := global.mixer()
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	cache := mixbox.BuildLatentCache(m)
+	built := time.Since(start)
+
+	f, err := os.Create(*out)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	if _, err := cache.WriteTo(w); err != nil {
+		f.Close()
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	fmt.Printf("wrote %s: %.1f MB, built in %v\n", *out, float64(cache.Size())/(1<<20), built.Round(time.Millisecond))
+	return nil
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/png"
+	"io"
+
+	"github.com/timf34/mixbox-go/mixbox"
+)
+
+func runChart(args []string) error {
+	fs := flag.NewFlagSet("chart", flag.ContinueOnError)
+	out := fs.String("o", "", "write the chart as a PNG to this file instead of printing it")
+	ratio := fs.Float64("t", 0.5, "share of the column color in each mix")
+	cell := fs.Int("cell", 48, "size of a chart cell in pixels")
+	paletteFlag := fs.String("palette", "", "comma-separated palette colors or a JSON palette file (default: built-in pigments)")
+	global := addGlobalFlags(fs)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: mixbox chart [-o file] [-t ratio] [-cell px] [-palette colors] [-lut file] [-json] [color...]")
+		fmt.Fprintln(fs.Output(), "Mixes every pair of colors; the first row and column hold the unmixed colors.")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 && *paletteFlag != "" {
+		return errors.New("give colors either as arguments or with -palette")
+	}
+	if *cell < 1 {
+		return fmt.Errorf("invalid cell size %d", *cell)
+	}
+
+	m, err := global.mixer()
+	if err != nil {
+		return err
+	}
+	var colors [][3]uint8
+	for _, s := range fs.Args() {
+		rgb, err := parseColor(s)
+		if err != nil {
+			return err
+		}
+		colors = append(colors, rgb)
+	}
+	if colors == nil {
+		pal, err := loadPalette(*paletteFlag)
+		if err != nil {
+			return err
+		}
+		colors = pal.RGBs()
+	}
+
+	// mixes[i][j] mixes row color i with column color j.
+	mixes := make([][][3]uint8, len(colors))
+	for i, a := range colors {
+		mixes[i] = make([][3]uint8, len(colors))
+		for j, b := range colors {
+			mixes[i][j] = m.Lerp(a, b, *ratio)
+		}
+	}
+
+	if *out != "" {
+		if err := writeFile(*out, func(w io.Writer) error {
+			return png.Encode(w, chartImage(colors, mixes, *cell))
+		}); err != nil {
+			return err
+		}
+	}
+	if global.json {
+		hexes := make([]string, len(colors))
+		grid := make([][]string, len(colors))
+		for i, c := range colors {
+			hexes[i] = formatHex(c)
+			grid[i] = make([]string, len(colors))
+			for j, mix := range mixes[i] {
+				grid[i][j] = formatHex(mix)
+			}
+		}
+		return writeJSON(struct {
+			T      float64    `json:"t"`
+			Colors []string   `json:"colors"`
+			Mixes  [][]string `json:"mixes"`
+			File   string     `json:"file,omitempty"`
+		}{*ratio, hexes, grid, *out})
+	}
+	if *out != "" {
+		return nil
+	}
+
+	fmt.Printf("%-8s", "")
+	for _, c := range colors {
+		fmt.Printf(" %s", formatHex(c))
+	}
+	fmt.Println()
+	for i, c := range colors {
+		fmt.Printf("%s", formatHex(c))
+		for _, mix := range mixes[i] {
+			fmt.Printf(" %s", formatHex(mix))
+		}
+		fmt.Println()
+	}
+	return nil
+}
+
+// chartImage lays the mixes out as a grid of square cells, with the
+// unmixed colors along the top and left edges.
+func chartImage(colors [][3]uint8, mixes [][][3]uint8, cell int) *image.NRGBA {
+	n := len(colors) + 1
+	img := image.NewNRGBA(image.Rect(0, 0, n*cell, n*cell))
+	fill := func(col, row int, rgb [3]uint8) {
+		r := image.Rect(col*cell, row*cell, (col+1)*cell, (row+1)*cell)
+		draw.Draw(img, r, image.NewUniform(mixbox.ColorFromRGB(rgb)), image.Point{}, draw.Src)
+	}
+	for i, c := range colors {
+		fill(i+1, 0, c)
+		fill(0, i+1, c)
+		for j, mix := range mixes[i] {
+			fill(j+1, i+1, mix)
+		}
+	}
+	return img
+}
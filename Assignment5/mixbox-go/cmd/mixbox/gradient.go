@@ -0,0 +1,167 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/timf34/mixbox-go/gradient"
+)
+
+func runGradient(args []string) error {
+	fs := flag.NewFlagSet("gradient", flag.ContinueOnError)
+	out := fs.String("o", "", "output file (default: standard output)")
+	format := fs.String("format", "", "png, svg or css (default: from the -o extension, else css)")
+	width := fs.Int("width", 512, "image width for png and svg")
+	height := fs.Int("height", 64, "image height for png and svg")
+	easing := fs.String("easing", "linear", "easing between stops: linear, ease, ease-in, ease-out, ease-in-out or smoothstep")
+	direction := fs.String("direction", "to right", "CSS gradient direction")
+	tolerance := fs.Float64("tolerance", gradient.DefaultTolerance, "largest CSS/SVG deviation from the pigment mix, in 8-bit steps")
+	samples := fs.Int("n", 0, "print this many evenly spaced colors instead")
+	global := addGlobalFlags(fs)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: mixbox gradient [flags] <color[@pos]> <color[@pos]>...")
+		fmt.Fprintln(fs.Output(), "Positions are in 0..1 or percentages; without them the colors are spaced evenly.")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() < 1 {
+		fs.Usage()
+		return errors.New("expected at least one color")
+	}
+
+	m, err := global.mixer()
+	if err != nil {
+		return err
+	}
+	ease, ok := gradient.EasingByName(*easing)
+	if !ok {
+		return fmt.Errorf("unknown easing %q", *easing)
+	}
+	stops, err := parseStops(fs.Args())
+	if err != nil {
+		return err
+	}
+	g, err := gradient.New(stops, gradient.WithMixer(m), gradient.WithEasing(ease), gradient.WithTolerance(*tolerance))
+	if err != nil {
+		return err
+	}
+
+	if *samples > 0 {
+		colors := g.Sample(*samples)
+		hexes := make([]string, len(colors))
+		for i, c := range colors {
+			hexes[i] = formatHex(c)
+		}
+		if global.json {
+			return writeJSON(struct {
+				Samples []string `json:"samples"`
+			}{hexes})
+		}
+		fmt.Println(strings.Join(hexes, "\n"))
+		return nil
+	}
+
+	if *format == "" {
+		*format = strings.TrimPrefix(strings.ToLower(filepath.Ext(*out)), ".")
+		if *format == "" {
+			*format = "css"
+		}
+	}
+	var write func(w io.Writer) error
+	switch *format {
+	case "png":
+		write = func(w io.Writer) error { return g.WritePNG(w, *width, *height) }
+	case "svg":
+		write = func(w io.Writer) error { return g.WriteSVG(w, *width, *height) }
+	case "css":
+		write = func(w io.Writer) error {
+			_, err := fmt.Fprintln(w, g.CSS(*direction))
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+
+	if global.json {
+		if *out == "" && *format != "css" {
+			return fmt.Errorf("-json prints no %s image; give -o to write it to a file", *format)
+		}
+		if *out != "" {
+			if err := writeFile(*out, write); err != nil {
+				return err
+			}
+		}
+		type stop struct {
+			Pos   float64 `json:"pos"`
+			Color string  `json:"color"`
+		}
+		result := struct {
+			Stops []stop `json:"stops"`
+			CSS   string `json:"css"`
+			File  string `json:"file,omitempty"`
+		}{CSS: g.CSS(*direction), File: *out}
+		for _, s := range g.FaithfulStops() {
+			result.Stops = append(result.Stops, stop{s.Pos, formatHex(s.Color)})
+		}
+		return writeJSON(result)
+	}
+	if *out == "" {
+		return write(os.Stdout)
+	}
+	return writeFile(*out, write)
+}
+
+// parseStops parses gradient stops of the form color[@pos]. If no stop has
+// a position, the colors are spaced evenly; otherwise every stop needs one.
+func parseStops(args []string) ([]gradient.Stop, error) {
+	stops := make([]gradient.Stop, len(args))
+	positioned := 0
+	for i, arg := range args {
+		s := arg
+		scale := 1.0
+		if strings.Contains(s, "@") && strings.HasSuffix(s, "%") {
+			s, scale = strings.TrimSuffix(s, "%"), 0.01
+		}
+		rgb, pos, err := parseWeighted(s, '@')
+		if err != nil {
+			return nil, err
+		}
+		if strings.Contains(s, "@") {
+			positioned++
+		}
+		stops[i] = gradient.Stop{Pos: pos * scale, Color: rgb}
+	}
+	switch positioned {
+	case len(args):
+	case 0:
+		for i := range stops {
+			stops[i].Pos = 0
+			if len(stops) > 1 {
+				stops[i].Pos = float64(i) / float64(len(stops)-1)
+			}
+		}
+	default:
+		return nil, errors.New("either all stops or none must have a position")
+	}
+	return stops, nil
+}
+
+// writeFile creates path and fills it with write.
+func writeFile(path string, write func(io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,162 @@
+// Command mixbox mixes colors the way pigments mix.
+//
+// Usage:
+//
+//	mixbox <command> [flags] [arguments]
+//
+// Run "mixbox help" for the list of commands and "mixbox <command> -h" for
+// a command's flags. Colors may be given in any CSS syntax: hex (#feec00,
+// #fe0), rgb(), hsl(), hwb(), lab(), oklch() or a color name, or as the
+// name or Color Index code of a built-in pigment ("phthalo-blue", PB29).
+// Commands that take a -palette accept a list of colors or a JSON palette
+// file; see package pigments. The mix,
+// gradient, recipe, chart, image and report commands accept -lut to load a lookup
+// table from a file and -json to print machine-readable output.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/timf34/mixbox-go/mixbox"
+	"github.com/timf34/mixbox-go/pigments"
+)
+
+type command struct {
+	name    string
+	summary string
+	run     func(args []string) error
+}
+
+var commands = []command{
+	{"mix", "mix two or more colors with weights", runMix},
+	{"gradient", "write a pigment gradient as PNG, SVG or CSS", runGradient},
+	{"recipe", "find a mix of palette colors that matches a target color", runRecipe},
+	{"chart", "draw a chart of every pairwise mix of a palette", runChart},
+	{"image", "glaze an image with a color or blend two images as paint", runImage},
+	{"report", "compare pigment mixing with RGB and OKLab interpolation", runReport},
+	{"pigments", "list, look up and export pigment palettes", runPigments},
+	{"cache", "precompute the latent cache file", runCache},
+	{"verify", "check mixing results against the reference implementation", runVerify},
+}
+
+func main() {
+	if len(os.Args) < 2 || os.Args[1] == "help" || os.Args[1] == "-h" || os.Args[1] == "--help" {
+		usage()
+		return
+	}
+	for _, c := range commands {
+		if c.name == os.Args[1] {
+			if err := c.run(os.Args[2:]); err != nil && !errors.Is(err, flag.ErrHelp) {
+				fmt.Fprintf(os.Stderr, "mixbox %s: %v\n", c.name, err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+	fmt.Fprintf(os.Stderr, "mixbox: unknown command %q\n", os.Args[1])
+	usage()
+	os.Exit(2)
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: mixbox <command> [flags] [arguments]")
+	fmt.Fprintln(os.Stderr, "\ncommands:")
+	for _, c := range commands {
+		fmt.Fprintf(os.Stderr, "  %-10s %s\n", c.name, c.summary)
+	}
+}
+
+// globalFlags are the flags shared by the mixing commands.
+type globalFlags struct {
+	lut  string
+	json bool
+}
+
+func addGlobalFlags(fs *flag.FlagSet) *globalFlags {
+	g := new(globalFlags)
+	fs.StringVar(&g.lut, "lut", "", "load the lookup table from this file instead of the built-in one")
+	fs.BoolVar(&g.json, "json", false, "write machine-readable JSON output")
+	return g
+}
+
+// mixer returns the Mixer selected by -lut. Any table in a format ParseLUT
+// understands is accepted, including custom ones.
+func (g *globalFlags) mixer() (*mixbox.Mixer, error) {
+	if g.lut == "" {
+		return mixbox.Default(), nil
+	}
+	data, err := os.ReadFile(g.lut)
+	if err != nil {
+		return nil, err
+	}
+	table, err := mixbox.ParseLUTWithChecksum(data, "")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", g.lut, err)
+	}
+	return mixbox.NewMixer(table)
+}
+
+// writeJSON writes v to stdout as indented JSON.
+func writeJSON(v any) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// parseColor parses any CSS color, see package colorparse, or the name or
+// code of a built-in pigment.
+func parseColor(s string) ([3]uint8, error) {
+	p, err := pigments.Builtin().Resolve(s)
+	return p.RGB, err
+}
+
+// loadPalette returns the palette selected by a -palette flag: the built-in
+// pigments if it is empty, a palette file if it ends in .json, and
+// otherwise a comma-separated list of colors and pigment names.
+func loadPalette(flagValue string) (*pigments.Palette, error) {
+	switch {
+	case flagValue == "":
+		return pigments.Builtin(), nil
+	case strings.HasSuffix(strings.ToLower(flagValue), ".json"):
+		return pigments.Load(flagValue)
+	}
+	var list []pigments.Pigment
+	for _, s := range splitList(flagValue) {
+		p, err := pigments.Builtin().Resolve(strings.TrimSpace(s))
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, p)
+	}
+	return pigments.NewPalette("", list)
+}
+
+func formatHex(rgb [3]uint8) string {
+	return fmt.Sprintf("#%02x%02x%02x", rgb[0], rgb[1], rgb[2])
+}
+
+// splitList splits a comma-separated list of colors, ignoring the commas
+// inside color functions such as rgb(...).
+func splitList(s string) []string {
+	var items []string
+	depth, start := 0, 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				items = append(items, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(items, s[start:])
+}
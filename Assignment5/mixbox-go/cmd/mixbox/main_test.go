@@ -0,0 +1,362 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"image/png"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/timf34/mixbox-go/mixbox"
+)
+
+// testMixer returns the default Mixer, skipping the test when mixbox was
+// built without an embedded table.
+func testMixer(t *testing.T) *mixbox.Mixer {
+	t.Helper()
+	m, ok := mixbox.DefaultOK()
+	if !ok {
+		t.Skip("built without an embedded LUT")
+	}
+	return m
+}
+
+// run runs the named command with args and returns what it printed to
+// standard output.
+func run(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	testMixer(t)
+	i := slices.IndexFunc(commands, func(c command) bool { return c.name == args[0] })
+	if i < 0 {
+		t.Fatalf("no command %q", args[0])
+	}
+	f, err := os.CreateTemp(t.TempDir(), "stdout")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	stdout, stderr := os.Stdout, os.Stderr
+	os.Stdout, os.Stderr = f, f
+	err = commands[i].run(args[1:])
+	os.Stdout, os.Stderr = stdout, stderr
+	out, readErr := os.ReadFile(f.Name())
+	if readErr != nil {
+		t.Fatal(readErr)
+	}
+	return string(out), err
+}
+
+// mustRun is like run but fails the test if the command fails.
+func mustRun(t *testing.T, args ...string) string {
+	t.Helper()
+	out, err := run(t, args...)
+	if err != nil {
+		t.Fatalf("mixbox %s: %v\n%s", strings.Join(args, " "), err, out)
+	}
+	return out
+}
+
+func decodeJSON(t *testing.T, out string, v any) {
+	t.Helper()
+	if err := json.Unmarshal([]byte(out), v); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, out)
+	}
+}
+
+func TestMix(t *testing.T) {
+	m := testMixer(t)
+	want := formatHex(m.Lerp([3]uint8{254, 236, 0}, [3]uint8{25, 0, 89}, 0.25))
+	out := mustRun(t, "mix", "#feec00:3", "rgb(25 0 89)")
+	if !strings.Contains(out, "75.0%  #feec00") || !strings.Contains(out, "= "+want) {
+		t.Errorf("mix printed:\n%s\nwant 75%% #feec00 and = %s", out, want)
+	}
+
+	var result struct {
+		Color  string
+		RGB    [3]uint8
+		Inputs []struct {
+			Color  string
+			Weight float64
+		}
+	}
+	decodeJSON(t, mustRun(t, "mix", "-json", "-lut", "../../mixbox/lut.dat", "#feec00:3", "PB29"), &result)
+	if result.Color != want || len(result.Inputs) != 2 || result.Inputs[0].Weight != 0.75 {
+		t.Errorf("mix -json = %+v, want color %s and weights 0.75, 0.25", result, want)
+	}
+}
+
+func TestCommandErrors(t *testing.T) {
+	dir := t.TempDir()
+	for _, args := range [][]string{
+		{"mix", "red"},
+		{"mix", "red", "not-a-color"},
+		{"mix", "red:x", "blue"},
+		{"mix", "red:-1", "blue"},
+		{"mix", "-lut", filepath.Join(dir, "missing.dat"), "red", "blue"},
+		{"gradient"},
+		{"gradient", "-easing", "bouncy", "red", "blue"},
+		{"gradient", "-format", "gif", "red", "blue"},
+		{"gradient", "red@0", "blue"},
+		{"gradient", "red@0.6", "blue@0.4"},
+		{"gradient", "-json", "-format", "png", "red", "blue"},
+		{"gradient", "-json", "-format", "svg", "red", "blue"},
+		{"recipe", "red", "blue"},
+		{"recipe", "-k", "0", "red"},
+		{"chart", "-cell", "0", "red", "blue"},
+		{"chart", "-palette", "red,blue", "green"},
+		{"image", "-color", "red", filepath.Join(dir, "in.png")},
+		{"image", "-color", "red", "-t", "2", "-o", filepath.Join(dir, "out.png"), filepath.Join(dir, "in.png")},
+		{"pigments", "PX99"},
+		{"verify", "extra"},
+		{"report", "-steps", "1"},
+		{"report", "-metric", "de99"},
+		{"report", "red"},
+		{"report", "red,blue,green"},
+		{"report", "red,nope"},
+	} {
+		if out, err := run(t, args...); err == nil {
+			t.Errorf("mixbox %s succeeded:\n%s", strings.Join(args, " "), out)
+		}
+	}
+}
+
+func TestGradient(t *testing.T) {
+	css := mustRun(t, "gradient", "-direction", "90deg", "#feec00", "#190059")
+	if !strings.HasPrefix(css, "linear-gradient(90deg, #feec00 0%, ") || !strings.HasSuffix(css, "#190059 100%)\n") {
+		t.Errorf("gradient printed %q", css)
+	}
+
+	samples := mustRun(t, "gradient", "-n", "3", "red@0%", "blue@100%")
+	if lines := strings.Fields(samples); len(lines) != 3 {
+		t.Errorf("gradient -n 3 printed %q", samples)
+	}
+
+	dir := t.TempDir()
+	pngFile := filepath.Join(dir, "g.png")
+	var result struct {
+		CSS   string
+		File  string
+		Stops []struct {
+			Pos   float64
+			Color string
+		}
+	}
+	decodeJSON(t, mustRun(t, "gradient", "-json", "-o", pngFile, "-width", "32", "-height", "4", "red", "blue"), &result)
+	if result.File != pngFile || !strings.HasPrefix(result.CSS, "linear-gradient(to right, ") || len(result.Stops) < 2 {
+		t.Errorf("gradient -json = %+v", result)
+	}
+	f, err := os.Open(pngFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	img, err := png.Decode(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b := img.Bounds(); b.Dx() != 32 || b.Dy() != 4 {
+		t.Errorf("PNG is %v, want 32x4", b)
+	}
+
+	// CSS output fits in the JSON, so it needs no file.
+	var cssOnly struct{ CSS, File string }
+	decodeJSON(t, mustRun(t, "gradient", "-json", "red", "blue"), &cssOnly)
+	if cssOnly.File != "" || cssOnly.CSS == "" {
+		t.Errorf("gradient -json without -o = %+v", cssOnly)
+	}
+
+	svg := mustRun(t, "gradient", "-format", "svg", "red", "blue")
+	if !strings.HasPrefix(svg, "<svg ") {
+		t.Errorf("gradient -format svg printed %q", svg)
+	}
+}
+
+func TestParseStops(t *testing.T) {
+	stops, err := parseStops([]string{"red@0", "rgb(0, 0, 255)@25%", "white@1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := []float64{stops[0].Pos, stops[1].Pos, stops[2].Pos}; !slices.Equal(got, []float64{0, 0.25, 1}) {
+		t.Errorf("positions %v", got)
+	}
+	if stops[1].Color != [3]uint8{0, 0, 255} {
+		t.Errorf("color %v", stops[1].Color)
+	}
+	stops, err = parseStops([]string{"red", "green", "blue"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := []float64{stops[0].Pos, stops[1].Pos, stops[2].Pos}; !slices.Equal(got, []float64{0, 0.5, 1}) {
+		t.Errorf("even positions %v", got)
+	}
+}
+
+func TestSplitList(t *testing.T) {
+	got := splitList("red, rgb(1, 2, 3),hsl(0 50% 50%),PB29")
+	want := []string{"red", " rgb(1, 2, 3)", "hsl(0 50% 50%)", "PB29"}
+	if !slices.Equal(got, want) {
+		t.Errorf("splitList = %q, want %q", got, want)
+	}
+}
+
+func TestRecipe(t *testing.T) {
+	m := testMixer(t)
+	var result struct {
+		Target, Mix string
+		Error       float64
+		Ingredients []struct {
+			Name   string
+			Weight float64
+		}
+	}
+	mix := formatHex(m.Lerp([3]uint8{254, 236, 0}, [3]uint8{25, 0, 89}, 0.5))
+	decodeJSON(t, mustRun(t, "recipe", "-json", "-k", "2", "-palette", "#feec00,#190059,#ffffff", mix), &result)
+	if result.Target != mix || len(result.Ingredients) != 2 || result.Error > 2.0/255 {
+		t.Errorf("recipe -json = %+v", result)
+	}
+	out := mustRun(t, "recipe", "-palette", "#feec00,#190059", mix)
+	if !strings.Contains(out, "target  "+mix) {
+		t.Errorf("recipe printed:\n%s", out)
+	}
+}
+
+func TestChart(t *testing.T) {
+	m := testMixer(t)
+	var result struct {
+		Colors []string
+		Mixes  [][]string
+	}
+	decodeJSON(t, mustRun(t, "chart", "-json", "-t", "0", "red", "blue"), &result)
+	if !slices.Equal(result.Colors, []string{"#ff0000", "#0000ff"}) || result.Mixes[0][1] != formatHex(m.Lerp([3]uint8{255, 0, 0}, [3]uint8{0, 0, 255}, 0)) {
+		t.Errorf("chart -json = %+v", result)
+	}
+
+	file := filepath.Join(t.TempDir(), "chart.png")
+	mustRun(t, "chart", "-o", file, "-cell", "4", "red", "blue", "white")
+	f, err := os.Open(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	cfg, err := png.DecodeConfig(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Width != 16 || cfg.Height != 16 {
+		t.Errorf("chart is %dx%d, want 16x16", cfg.Width, cfg.Height)
+	}
+}
+
+func TestImage(t *testing.T) {
+	dir := t.TempDir()
+	in, out := filepath.Join(dir, "in.png"), filepath.Join(dir, "out.png")
+	mustRun(t, "chart", "-o", in, "-cell", "2", "red", "blue")
+	var result struct {
+		Format        string
+		Width, Height int
+	}
+	decodeJSON(t, mustRun(t, "image", "-json", "-color", "white", "-t", "0.5", "-o", out, in), &result)
+	if result.Format != "png" || result.Width != 6 || result.Height != 6 {
+		t.Errorf("image -json = %+v", result)
+	}
+	blended := filepath.Join(dir, "blend.png")
+	if got := mustRun(t, "image", "-with", out, "-depth", "16", "-o", blended, in); !strings.HasPrefix(got, "wrote "+blended) {
+		t.Errorf("image -with printed %q", got)
+	}
+}
+
+func TestPigments(t *testing.T) {
+	out := mustRun(t, "pigments", "PB29", "burnt-sienna")
+	if lines := strings.Split(strings.TrimSpace(out), "\n"); len(lines) != 3 || !strings.HasPrefix(lines[0], "NAME") || !strings.Contains(lines[1], "PB29") {
+		t.Errorf("pigments printed:\n%s", out)
+	}
+
+	file := filepath.Join(t.TempDir(), "palette.json")
+	mustRun(t, "pigments", "-save", file, "-name", "blues", "PB29", "PB15:3")
+	var list []struct{ Code string }
+	decodeJSON(t, mustRun(t, "pigments", "-json", "-palette", file), &list)
+	if len(list) != 2 || list[0].Code != "PB29" || list[1].Code != "PB15:3" {
+		t.Errorf("saved palette holds %+v", list)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	out := mustRun(t, "verify", "-pairs", "100")
+	if !strings.Contains(out, "reference: ") || !strings.Contains(out, "fixed: ") {
+		t.Errorf("verify printed:\n%s", out)
+	}
+}
+
+func TestReport(t *testing.T) {
+	m := testMixer(t)
+	out := mustRun(t, "report", "-steps", "3", "-metric", "de76", "#feec00,#190059")
+	rows, err := csv.NewReader(strings.NewReader(out)).ReadAll()
+	if err != nil {
+		t.Fatalf("invalid CSV: %v\n%s", err, out)
+	}
+	header := []string{"color1", "color2", "t", "mixbox", "srgb", "linear", "oklab", "srgb_delta", "linear_delta", "oklab_delta"}
+	if len(rows) != 4 || !slices.Equal(rows[0], header) {
+		t.Fatalf("report printed:\n%s", out)
+	}
+	a, b := [3]uint8{254, 236, 0}, [3]uint8{25, 0, 89}
+	for i, row := range rows[1:] {
+		tt := float64(i) / 2
+		want := []string{"#feec00", "#190059", strconv.FormatFloat(tt, 'f', -1, 64), formatHex(m.Lerp(a, b, tt)), formatHex(lerpSRGB(a, b, tt))}
+		if !slices.Equal(row[:5], want) {
+			t.Errorf("row %d = %q, want it to start with %q", i+1, row, want)
+		}
+		got, err := strconv.ParseFloat(row[7], 64)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mixed, srgb := m.Lerp(a, b, tt), lerpSRGB(a, b, tt)
+		if d := mixbox.DeltaE76(mixbox.RGBToLab(mixed), mixbox.RGBToLab(srgb)); math.Abs(got-d) > 5e-4 {
+			t.Errorf("row %d: srgb delta %v, want %.3f", i+1, got, d)
+		}
+	}
+
+	var summary struct {
+		Metric string
+		Pairs  []struct {
+			Color1, Color2 string
+			Divergence     map[string]struct{ Max, Mean float64 }
+		}
+	}
+	decodeJSON(t, mustRun(t, "report", "-json"), &summary)
+	if summary.Metric != "de2000" || len(summary.Pairs) != len(defaultPairs) {
+		t.Fatalf("report -json = %+v", summary)
+	}
+	for _, p := range summary.Pairs {
+		for _, in := range interpolations {
+			d, ok := p.Divergence[in.name]
+			if !ok || d.Mean < 0 || d.Mean > d.Max {
+				t.Errorf("%s + %s: %s divergence %+v", p.Color1, p.Color2, in.name, d)
+			}
+		}
+	}
+
+	dir := t.TempDir()
+	htmlFile, csvFile := filepath.Join(dir, "report.html"), filepath.Join(dir, "report.csv")
+	if out := mustRun(t, "report", "-html", htmlFile, "-csv", csvFile, "-metric", "deok", "red,blue"); out != "" {
+		t.Errorf("report with -html and -csv printed %q", out)
+	}
+	page, err := os.ReadFile(htmlFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(page), "deok") || strings.Count(string(page), `class="swatch"`) != 11*(1+len(interpolations)) {
+		t.Errorf("HTML report:\n%s", page)
+	}
+	table, err := os.ReadFile(csvFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Count(string(table), "\n"); lines != 12 {
+		t.Errorf("CSV report has %d lines, want 12", lines)
+	}
+}
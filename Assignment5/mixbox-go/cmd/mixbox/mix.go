@@ -0,0 +1,89 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/timf34/mixbox-go/mixbox"
+)
+
+func runMix(args []string) error {
+	fs := flag.NewFlagSet("mix", flag.ContinueOnError)
+	global := addGlobalFlags(fs)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: mixbox mix [-lut file] [-json] <color[:weight]> <color[:weight]>...")
+		fmt.Fprintln(fs.Output(), "Mixes the colors in proportion to their weights, which default to 1.")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() < 2 {
+		fs.Usage()
+		return errors.New("expected at least two colors")
+	}
+
+	m, err := global.mixer()
+	if err != nil {
+		return err
+	}
+	rgbs := make([][3]uint8, fs.NArg())
+	colors := make([]mixbox.Color, fs.NArg())
+	weights := make([]float64, fs.NArg())
+	for i, arg := range fs.Args() {
+		rgbs[i], weights[i], err = parseWeighted(arg, ':')
+		if err != nil {
+			return err
+		}
+		colors[i] = mixbox.RGB(rgbs[i])
+	}
+	latent, err := m.Mix(colors, weights)
+	if err != nil {
+		return err
+	}
+	result := latent.RGB()
+
+	var total float64
+	for _, w := range weights {
+		total += w
+	}
+	if global.json {
+		type input struct {
+			Color  string  `json:"color"`
+			Weight float64 `json:"weight"`
+		}
+		out := struct {
+			Color  string   `json:"color"`
+			RGB    [3]uint8 `json:"rgb"`
+			Inputs []input  `json:"inputs"`
+		}{Color: formatHex(result), RGB: result}
+		for i, rgb := range rgbs {
+			out.Inputs = append(out.Inputs, input{formatHex(rgb), weights[i] / total})
+		}
+		return writeJSON(out)
+	}
+
+	for i, rgb := range rgbs {
+		fmt.Printf("  %5.1f%%  %s\n", 100*weights[i]/total, formatHex(rgb))
+	}
+	fmt.Printf("= %s  rgb(%d, %d, %d)\n", formatHex(result), result[0], result[1], result[2])
+	return nil
+}
+
+// parseWeighted parses a color optionally followed by sep and a number,
+// as in "#feec00:3" or "blue@0.5". The number defaults to 1.
+func parseWeighted(s string, sep byte) ([3]uint8, float64, error) {
+	if i := strings.LastIndexByte(s, sep); i >= 0 {
+		w, err := strconv.ParseFloat(s[i+1:], 64)
+		if err != nil {
+			return [3]uint8{}, 0, fmt.Errorf("invalid number in %q", s)
+		}
+		rgb, err := parseColor(s[:i])
+		return rgb, w, err
+	}
+	rgb, err := parseColor(s)
+	return rgb, 1, err
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/timf34/mixbox-go/pigments"
+)
+
+func runPigments(args []string) error {
+	fs := flag.NewFlagSet("pigments", flag.ContinueOnError)
+	paletteFlag := fs.String("palette", "", "comma-separated palette colors or a JSON palette file (default: built-in pigments)")
+	save := fs.String("save", "", "write the selected pigments to this file as a JSON palette")
+	name := fs.String("name", "", "name of the palette written by -save")
+	asJSON := fs.Bool("json", false, "write machine-readable JSON output")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: mixbox pigments [-palette colors|file] [-save file [-name name]] [-json] [name|code...]")
+		fmt.Fprintln(fs.Output(), "Lists the palette's pigments, or looks up the given pigment names and Color Index codes.")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	pal, err := loadPalette(*paletteFlag)
+	if err != nil {
+		return err
+	}
+	list := pal.Pigments()
+	if fs.NArg() > 0 {
+		list = nil
+		for _, s := range fs.Args() {
+			p, ok := pal.Lookup(s)
+			if !ok {
+				return fmt.Errorf("no pigment named or coded %q in palette %q", s, pal.Name())
+			}
+			list = append(list, p)
+		}
+	}
+
+	if *save != "" {
+		paletteName := *name
+		if paletteName == "" {
+			paletteName = pal.Name()
+		}
+		out, err := pigments.NewPalette(paletteName, list)
+		if err != nil {
+			return err
+		}
+		if err := out.Save(*save); err != nil {
+			return err
+		}
+		if !*asJSON {
+			return nil
+		}
+	}
+	if *asJSON {
+		return writeJSON(list)
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tCODE\tCOLOR\tOPACITY\tSTAINING")
+	for _, p := range list {
+		code := p.Code
+		if code == "" {
+			code = "-"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", p.Name, code, formatHex(p.RGB), p.Opacity, p.Staining)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"sort"
+)
+
+func runRecipe(args []string) error {
+	fs := flag.NewFlagSet("recipe", flag.ContinueOnError)
+	maxPigments := fs.Int("k", 3, "maximum number of pigments in the recipe")
+	paletteFlag := fs.String("palette", "", "comma-separated palette colors or a JSON palette file (default: built-in pigments)")
+	global := addGlobalFlags(fs)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: mixbox recipe [-k n] [-palette colors] [-lut file] [-json] <target>")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return errors.New("expected exactly one target color")
+	}
+
+	m, err := global.mixer()
+	if err != nil {
+		return err
+	}
+	target, err := parseColor(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	pal, err := loadPalette(*paletteFlag)
+	if err != nil {
+		return err
+	}
+	palette := pal.Pigments()
+	recipe, err := m.SolveRecipe(target, pal.RGBs(), *maxPigments)
+	if err != nil {
+		return err
+	}
+
+	order := make([]int, 0, len(palette))
+	for i, w := range recipe.Weights {
+		if w > 0 {
+			order = append(order, i)
+		}
+	}
+	sort.Slice(order, func(a, b int) bool { return recipe.Weights[order[a]] > recipe.Weights[order[b]] })
+
+	if global.json {
+		type ingredient struct {
+			Name   string  `json:"name"`
+			Color  string  `json:"color"`
+			Weight float64 `json:"weight"`
+		}
+		out := struct {
+			Target      string       `json:"target"`
+			Mix         string       `json:"mix"`
+			Error       float64      `json:"error"`
+			Ingredients []ingredient `json:"ingredients"`
+		}{Target: formatHex(target), Mix: formatHex(recipe.RGB), Error: recipe.Error, Ingredients: []ingredient{}}
+		for _, i := range order {
+			out.Ingredients = append(out.Ingredients, ingredient{palette[i].Name, formatHex(palette[i].RGB), recipe.Weights[i]})
+		}
+		return writeJSON(out)
+	}
+
+	fmt.Printf("target  %s\n", formatHex(target))
+	fmt.Printf("mix     %s  (error %.4f)\n", formatHex(recipe.RGB), recipe.Error)
+	for _, i := range order {
+		fmt.Printf("  %5.1f%%  %s  %s\n", 100*recipe.Weights[i], formatHex(palette[i].RGB), palette[i].Name)
+	}
+	return nil
+}
@@ -0,0 +1,297 @@
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"html/template"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/timf34/mixbox-go/mixbox"
+	"github.com/timf34/mixbox-go/pigments"
+)
+
+// defaultPairs are the color pairs reported when none are given: classic
+// complementary and near-complementary pigment mixes.
+var defaultPairs = [][2][3]uint8{
+	{pigments.CadmiumYellow.RGB, pigments.UltramarineBlue.RGB},
+	{pigments.HansaYellow.RGB, pigments.PhthaloBlue.RGB},
+	{pigments.CadmiumRed.RGB, pigments.PhthaloGreen.RGB},
+	{pigments.CadmiumOrange.RGB, pigments.CobaltBlue.RGB},
+	{pigments.QuinacridoneMagenta.RGB, pigments.SapGreen.RGB},
+	{{255, 255, 255}, pigments.UltramarineBlue.RGB},
+}
+
+// interpolation is a way of mixing two colors that the report compares
+// against Mixbox.
+type interpolation struct {
+	name string
+	lerp func(a, b [3]uint8, t float64) [3]uint8
+}
+
+var interpolations = []interpolation{
+	{"srgb", lerpSRGB},
+	{"linear", lerpLinear},
+	{"oklab", lerpOKLab},
+}
+
+func lerpSRGB(a, b [3]uint8, t float64) [3]uint8 {
+	var out [3]uint8
+	for i := range out {
+		out[i] = uint8(math.Round(float64(a[i])*(1-t) + float64(b[i])*t))
+	}
+	return out
+}
+
+func lerpLinear(a, b [3]uint8, t float64) [3]uint8 {
+	tf := mixbox.TransferSRGB
+	var out [3]float64
+	for i := range out {
+		x := tf.ToLinear(float64(a[i]) / 255)
+		y := tf.ToLinear(float64(b[i]) / 255)
+		out[i] = tf.FromLinear(x*(1-t) + y*t)
+	}
+	return floatTo8(out)
+}
+
+func lerpOKLab(a, b [3]uint8, t float64) [3]uint8 {
+	x, y := mixbox.RGBToOKLab(a), mixbox.RGBToOKLab(b)
+	return floatTo8(mixbox.OKLabToFloatRGB(mixbox.OKLab{
+		L: x.L*(1-t) + y.L*t,
+		A: x.A*(1-t) + y.A*t,
+		B: x.B*(1-t) + y.B*t,
+	}))
+}
+
+func floatTo8(rgb [3]float64) [3]uint8 {
+	var out [3]uint8
+	for i, v := range rgb {
+		out[i] = uint8(math.Round(math.Max(0, math.Min(1, v)) * 255))
+	}
+	return out
+}
+
+// metrics are the color differences -metric accepts.
+var metrics = map[string]func(a, b [3]uint8) float64{
+	"de76":   func(a, b [3]uint8) float64 { return mixbox.DeltaE76(mixbox.RGBToLab(a), mixbox.RGBToLab(b)) },
+	"de94":   func(a, b [3]uint8) float64 { return mixbox.DeltaE94(mixbox.RGBToLab(a), mixbox.RGBToLab(b)) },
+	"de2000": func(a, b [3]uint8) float64 { return mixbox.DeltaE2000(mixbox.RGBToLab(a), mixbox.RGBToLab(b)) },
+	"deok":   func(a, b [3]uint8) float64 { return mixbox.DeltaEOK(mixbox.RGBToOKLab(a), mixbox.RGBToOKLab(b)) },
+}
+
+// reportStep is one position along a pair's gradient.
+type reportStep struct {
+	T      float64
+	Mixbox [3]uint8
+	Others [][3]uint8 // in the order of interpolations
+	Deltas []float64  // distance of each of Others from Mixbox
+}
+
+type reportPair struct {
+	A, B  [3]uint8
+	Steps []reportStep
+	Max   []float64 // per interpolation
+	Mean  []float64
+}
+
+func runReport(args []string) error {
+	fs := flag.NewFlagSet("report", flag.ContinueOnError)
+	steps := fs.Int("steps", 11, "number of positions along each gradient, including both ends")
+	metric := fs.String("metric", "de2000", "color difference: de76, de94, de2000 or deok")
+	csvOut := fs.String("csv", "", "write the CSV table to this file (default: stdout unless -html or -json is given)")
+	htmlOut := fs.String("html", "", "write an HTML page with swatches to this file")
+	global := addGlobalFlags(fs)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: mixbox report [-steps n] [-metric name] [-csv file] [-html file] [-lut file] [-json] [color1,color2...]")
+		fmt.Fprintln(fs.Output(), "Compares linear sRGB, linear-light and OKLab interpolation of each pair with")
+		fmt.Fprintln(fs.Output(), "the pigment mix and reports how far each one diverges from it.")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *steps < 2 {
+		return fmt.Errorf("invalid step count %d", *steps)
+	}
+	diff, ok := metrics[*metric]
+	if !ok {
+		return fmt.Errorf("unknown metric %q", *metric)
+	}
+
+	m, err := global.mixer()
+	if err != nil {
+		return err
+	}
+	pairs := defaultPairs
+	if fs.NArg() > 0 {
+		pairs = nil
+		for _, arg := range fs.Args() {
+			items := splitList(arg)
+			if len(items) != 2 {
+				return fmt.Errorf("pair %q: want two comma-separated colors", arg)
+			}
+			var pair [2][3]uint8
+			for i, s := range items {
+				if pair[i], err = parseColor(strings.TrimSpace(s)); err != nil {
+					return err
+				}
+			}
+			pairs = append(pairs, pair)
+		}
+	}
+
+	report := make([]reportPair, len(pairs))
+	for i, pair := range pairs {
+		report[i] = comparePair(m, pair[0], pair[1], *steps, diff)
+	}
+
+	if *htmlOut != "" {
+		if err := writeFile(*htmlOut, func(w io.Writer) error {
+			return writeReportHTML(w, report, *metric)
+		}); err != nil {
+			return err
+		}
+	}
+	if *csvOut != "" {
+		if err := writeFile(*csvOut, func(w io.Writer) error {
+			return writeReportCSV(w, report)
+		}); err != nil {
+			return err
+		}
+	}
+	if global.json {
+		return writeReportJSON(report, *metric)
+	}
+	if *csvOut == "" && *htmlOut == "" {
+		return writeReportCSV(os.Stdout, report)
+	}
+	return nil
+}
+
+func comparePair(m *mixbox.Mixer, a, b [3]uint8, steps int, diff func(a, b [3]uint8) float64) reportPair {
+	p := reportPair{
+		A:    a,
+		B:    b,
+		Max:  make([]float64, len(interpolations)),
+		Mean: make([]float64, len(interpolations)),
+	}
+	for s := 0; s < steps; s++ {
+		t := float64(s) / float64(steps-1)
+		step := reportStep{T: t, Mixbox: m.Lerp(a, b, t)}
+		for i, in := range interpolations {
+			c := in.lerp(a, b, t)
+			d := diff(step.Mixbox, c)
+			step.Others = append(step.Others, c)
+			step.Deltas = append(step.Deltas, d)
+			p.Max[i] = math.Max(p.Max[i], d)
+			p.Mean[i] += d / float64(steps)
+		}
+		p.Steps = append(p.Steps, step)
+	}
+	return p
+}
+
+func writeReportCSV(w io.Writer, report []reportPair) error {
+	cw := csv.NewWriter(w)
+	header := []string{"color1", "color2", "t", "mixbox"}
+	for _, in := range interpolations {
+		header = append(header, in.name)
+	}
+	for _, in := range interpolations {
+		header = append(header, in.name+"_delta")
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, p := range report {
+		for _, s := range p.Steps {
+			row := []string{formatHex(p.A), formatHex(p.B), strconv.FormatFloat(s.T, 'f', -1, 64), formatHex(s.Mixbox)}
+			for _, c := range s.Others {
+				row = append(row, formatHex(c))
+			}
+			for _, d := range s.Deltas {
+				row = append(row, strconv.FormatFloat(d, 'f', 3, 64))
+			}
+			if err := cw.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func writeReportJSON(report []reportPair, metric string) error {
+	type summary struct {
+		Max  float64 `json:"max"`
+		Mean float64 `json:"mean"`
+	}
+	type pair struct {
+		Color1     string             `json:"color1"`
+		Color2     string             `json:"color2"`
+		Divergence map[string]summary `json:"divergence"`
+	}
+	out := struct {
+		Metric string `json:"metric"`
+		Pairs  []pair `json:"pairs"`
+	}{Metric: metric}
+	for _, p := range report {
+		jp := pair{formatHex(p.A), formatHex(p.B), make(map[string]summary)}
+		for i, in := range interpolations {
+			jp.Divergence[in.name] = summary{p.Max[i], p.Mean[i]}
+		}
+		out.Pairs = append(out.Pairs, jp)
+	}
+	return writeJSON(out)
+}
+
+var reportTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"hex":   formatHex,
+	"delta": func(d float64) string { return strconv.FormatFloat(d, 'f', 2, 64) },
+	"names": func() []string {
+		names := make([]string, len(interpolations))
+		for i, in := range interpolations {
+			names[i] = in.name
+		}
+		return names
+	},
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Mixbox interpolation report</title>
+<style>
+body { font-family: sans-serif; margin: 2em; color: #222; }
+table { border-collapse: collapse; margin-bottom: 2.5em; }
+th, td { padding: 2px 6px; text-align: left; font-size: 13px; }
+td.swatch { width: 40px; height: 32px; padding: 0; }
+td.delta { text-align: center; color: #666; font-size: 11px; }
+</style>
+</head>
+<body>
+<h1>Interpolation compared with pigment mixing</h1>
+<p>Each row interpolates between the two colors; the number under a swatch is its {{.Metric}} from the Mixbox row above it.</p>
+{{range .Pairs}}
+<h2><span style="color:{{hex .A}}">&#9632;</span> {{hex .A}} + <span style="color:{{hex .B}}">&#9632;</span> {{hex .B}}</h2>
+<table>
+<tr><th>mixbox</th>{{range .Steps}}<td class="swatch" style="background:{{hex .Mixbox}}" title="t={{.T}} {{hex .Mixbox}}"></td>{{end}}<th></th></tr>
+{{$pair := .}}{{range $i, $name := names}}
+<tr><th>{{$name}}</th>{{range $pair.Steps}}<td class="swatch" style="background:{{hex (index .Others $i)}}" title="t={{.T}} {{hex (index .Others $i)}}"></td>{{end}}<td>max {{delta (index $pair.Max $i)}}, mean {{delta (index $pair.Mean $i)}}</td></tr>
+<tr><th></th>{{range $pair.Steps}}<td class="delta">{{delta (index .Deltas $i)}}</td>{{end}}<td></td></tr>
+{{end}}
+</table>
+{{end}}
+</body>
+</html>
+`))
+
+func writeReportHTML(w io.Writer, report []reportPair, metric string) error {
+	return reportTemplate.Execute(w, struct {
+		Metric string
+		Pairs  []reportPair
+	}{metric, report})
+}
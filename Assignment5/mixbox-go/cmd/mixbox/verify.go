@@ -0,0 +1,42 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/timf34/mixbox-go/mixbox"
+)
+
+func runVerify(args []string) error {
+	fs := flag.NewFlagSet("verify", flag.ContinueOnError)
+	pairs := fs.Int("pairs", 1000000, "random color pairs for the fixed-point comparison")
+	seed := fs.Int64("seed", 1, "seed for the random color pairs")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: mixbox verify [-pairs n] [-seed n]")
+		fmt.Fprintln(fs.Output(), "Checks Lerp against the reference vectors and LerpFixed against Lerp.")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		fs.Usage()
+		return errors.New("unexpected arguments")
+	}
+
+	m := mixbox.Default()
+	reference, err := mixbox.Verify(m)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("reference: %v\n", reference)
+
+	fixed := mixbox.VerifyFixed(m, *pairs, *seed)
+	fmt.Printf("fixed:     %v\n", fixed)
+	if !fixed.WithinOne() {
+		w := fixed.Worst
+		return fmt.Errorf("LerpFixed(%s, %s, %#04x) is off by %d", formatHex(w.RGB1), formatHex(w.RGB2), w.T, fixed.MaxDeviation)
+	}
+	return nil
+}
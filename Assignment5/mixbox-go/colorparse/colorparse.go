@@ -0,0 +1,376 @@
+// Package colorparse parses and formats colors in CSS Color Level 4 syntax:
+// hex notation, rgb(), rgba(), hsl(), hsla(), hwb(), lab(), lch(),
+// oklab(), oklch() and the named colors. Hex colors need their leading
+// '#', as in CSS, so that words such as "bad" or "fed" are not colors.
+//
+// Parsed colors are held in sRGB. Colors given in lab(), lch(), oklab()
+// or oklch() may fall outside the sRGB gamut; their channels then lie
+// outside 0..1 until converted with RGB or NRGBA, which clip them.
+package colorparse
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/timf34/mixbox-go/mixbox"
+)
+
+// Color is a parsed color: sRGB channels and straight alpha, nominally in
+// 0..1.
+type Color struct {
+	R, G, B, A float64
+}
+
+// FromRGB returns the opaque Color of an 8-bit sRGB triple.
+func FromRGB(rgb [3]uint8) Color {
+	return Color{float64(rgb[0]) / 255, float64(rgb[1]) / 255, float64(rgb[2]) / 255, 1}
+}
+
+// RGB returns the color as 8-bit sRGB, clipping out-of-gamut channels and
+// dropping alpha.
+func (c Color) RGB() [3]uint8 {
+	return [3]uint8{to8(c.R), to8(c.G), to8(c.B)}
+}
+
+// NRGBA returns the color as a straight-alpha color.NRGBA.
+func (c Color) NRGBA() color.NRGBA {
+	return color.NRGBA{R: to8(c.R), G: to8(c.G), B: to8(c.B), A: to8(c.A)}
+}
+
+// Lab returns the color in CIELAB.
+func (c Color) Lab() mixbox.Lab {
+	return mixbox.FloatRGBToLab([3]float64{c.R, c.G, c.B})
+}
+
+// OKLab returns the color in OKLab.
+func (c Color) OKLab() mixbox.OKLab {
+	return mixbox.FloatRGBToOKLab([3]float64{c.R, c.G, c.B})
+}
+
+func fromFloatRGB(rgb [3]float64) Color {
+	return Color{R: rgb[0], G: rgb[1], B: rgb[2]}
+}
+
+// InGamut reports whether the color lies inside sRGB.
+func (c Color) InGamut() bool {
+	const eps = 1e-6
+	for _, v := range [3]float64{c.R, c.G, c.B} {
+		if v < -eps || v > 1+eps {
+			return false
+		}
+	}
+	return true
+}
+
+func to8(v float64) uint8 {
+	return uint8(math.Round(clamp01(v) * 255))
+}
+
+func clamp01(v float64) float64 {
+	return math.Max(0, math.Min(1, v))
+}
+
+// SyntaxError reports a string that is not a valid color.
+type SyntaxError struct {
+	Input string
+	Msg   string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("colorparse: invalid color %q: %s", e.Input, e.Msg)
+}
+
+// Parse parses a CSS color.
+func Parse(s string) (Color, error) {
+	text := strings.ToLower(strings.TrimSpace(s))
+	fail := func(format string, args ...any) (Color, error) {
+		return Color{}, &SyntaxError{Input: s, Msg: fmt.Sprintf(format, args...)}
+	}
+	if text == "" {
+		return fail("empty string")
+	}
+
+	if open := strings.IndexByte(text, '('); open >= 0 {
+		if !strings.HasSuffix(text, ")") {
+			return fail("missing closing parenthesis")
+		}
+		name := strings.TrimSpace(text[:open])
+		fn, ok := functions[name]
+		if !ok {
+			return fail("unknown function %s()", name)
+		}
+		args, alpha, err := splitArgs(text[open+1 : len(text)-1])
+		if err != nil {
+			return fail("%s(): %v", name, err)
+		}
+		if len(args) != 3 {
+			return fail("%s() takes 3 components, got %d", name, len(args))
+		}
+		c, err := fn(args)
+		if err != nil {
+			return fail("%s(): %v", name, err)
+		}
+		c.A = 1
+		if alpha != "" {
+			if c.A, err = parseAlpha(alpha); err != nil {
+				return fail("%s(): alpha: %v", name, err)
+			}
+		}
+		return c, nil
+	}
+
+	if hex, ok := strings.CutPrefix(text, "#"); ok {
+		c, err := parseHex(hex)
+		if err != nil {
+			return fail("%v", err)
+		}
+		return c, nil
+	}
+	if text == "transparent" {
+		return Color{}, nil
+	}
+	if rgb, ok := namedColors[text]; ok {
+		return FromRGB(rgb), nil
+	}
+	if _, err := parseHex(text); err == nil {
+		return fail("hex colors start with '#'")
+	}
+	return fail("not a hex color, color function or color name")
+}
+
+// ParseRGB parses a CSS color and returns it as 8-bit sRGB; see
+// Color.RGB.
+func ParseRGB(s string) ([3]uint8, error) {
+	c, err := Parse(s)
+	return c.RGB(), err
+}
+
+// MustParse is like Parse but panics on error. It is meant for constants.
+func MustParse(s string) Color {
+	c, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+func parseHex(hex string) (Color, error) {
+	switch len(hex) {
+	case 3, 4:
+		expanded := make([]byte, 0, 8)
+		for i := 0; i < len(hex); i++ {
+			expanded = append(expanded, hex[i], hex[i])
+		}
+		hex = string(expanded)
+	case 6, 8:
+	default:
+		return Color{}, fmt.Errorf("hex color has %d digits, want 3, 4, 6 or 8", len(hex))
+	}
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return Color{}, fmt.Errorf("%q is not hexadecimal", hex)
+	}
+	if len(hex) == 6 {
+		v = v<<8 | 0xff
+	}
+	return Color{
+		R: float64(v>>24&0xff) / 255,
+		G: float64(v>>16&0xff) / 255,
+		B: float64(v>>8&0xff) / 255,
+		A: float64(v&0xff) / 255,
+	}, nil
+}
+
+// splitArgs splits the inside of a color function into its components and
+// the alpha, accepting both the legacy comma syntax "r, g, b, a" and the
+// modern "r g b / a".
+func splitArgs(inner string) ([]string, string, error) {
+	var args []string
+	var alpha string
+	if strings.Contains(inner, ",") {
+		for _, f := range strings.Split(inner, ",") {
+			f = strings.TrimSpace(f)
+			if f == "" {
+				return nil, "", fmt.Errorf("empty component")
+			}
+			args = append(args, f)
+		}
+		if len(args) == 4 {
+			args, alpha = args[:3], args[3]
+		}
+		return args, alpha, nil
+	}
+	main, a, slash := strings.Cut(inner, "/")
+	if slash {
+		alpha = strings.TrimSpace(a)
+		if alpha == "" {
+			return nil, "", fmt.Errorf("missing alpha after /")
+		}
+	}
+	return strings.Fields(main), alpha, nil
+}
+
+// component is a parsed number with its unit: "", "%" or an angle unit.
+type component struct {
+	value float64
+	unit  string
+}
+
+func parseComponent(s string) (component, error) {
+	if s == "none" {
+		return component{}, nil
+	}
+	for _, unit := range []string{"%", "deg", "grad", "rad", "turn"} {
+		if v, ok := strings.CutSuffix(s, unit); ok {
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+				return component{}, fmt.Errorf("invalid value %q", s)
+			}
+			return component{f, unit}, nil
+		}
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return component{}, fmt.Errorf("invalid value %q", s)
+	}
+	return component{f, ""}, nil
+}
+
+// number returns c as a number, where 100% equals full.
+func (c component) number(full float64) (float64, error) {
+	switch c.unit {
+	case "":
+		return c.value, nil
+	case "%":
+		return c.value / 100 * full, nil
+	}
+	return 0, fmt.Errorf("unexpected unit %s", c.unit)
+}
+
+// degrees returns c as a hue angle in degrees.
+func (c component) degrees() (float64, error) {
+	var deg float64
+	switch c.unit {
+	case "", "deg":
+		deg = c.value
+	case "grad":
+		deg = c.value * 0.9
+	case "rad":
+		deg = c.value * 180 / math.Pi
+	case "turn":
+		deg = c.value * 360
+	default:
+		return 0, fmt.Errorf("hue must be a number or angle, got %v%s", c.value, c.unit)
+	}
+	deg = math.Mod(deg, 360)
+	if deg < 0 {
+		deg += 360
+	}
+	return deg, nil
+}
+
+func parseAlpha(s string) (float64, error) {
+	c, err := parseComponent(s)
+	if err != nil {
+		return 0, err
+	}
+	a, err := c.number(1)
+	return clamp01(a), err
+}
+
+// parseComponents parses args and converts each with the matching entry
+// of conv.
+func parseComponents(args []string, conv [3]func(component) (float64, error)) ([3]float64, error) {
+	var out [3]float64
+	for i, arg := range args {
+		c, err := parseComponent(arg)
+		if err != nil {
+			return out, err
+		}
+		if out[i], err = conv[i](c); err != nil {
+			return out, err
+		}
+	}
+	return out, nil
+}
+
+func numberOf(full float64) func(component) (float64, error) {
+	return func(c component) (float64, error) { return c.number(full) }
+}
+
+func hue(c component) (float64, error) { return c.degrees() }
+
+var functions = map[string]func(args []string) (Color, error){
+	"rgb":   parseRGBFunc,
+	"rgba":  parseRGBFunc,
+	"hsl":   parseHSLFunc,
+	"hsla":  parseHSLFunc,
+	"hwb":   parseHWBFunc,
+	"lab":   parseLabFunc,
+	"lch":   parseLCHFunc,
+	"oklab": parseOKLabFunc,
+	"oklch": parseOKLCHFunc,
+}
+
+func parseRGBFunc(args []string) (Color, error) {
+	v, err := parseComponents(args, [3]func(component) (float64, error){numberOf(255), numberOf(255), numberOf(255)})
+	if err != nil {
+		return Color{}, err
+	}
+	return Color{R: clamp01(v[0] / 255), G: clamp01(v[1] / 255), B: clamp01(v[2] / 255)}, nil
+}
+
+func parseHSLFunc(args []string) (Color, error) {
+	v, err := parseComponents(args, [3]func(component) (float64, error){hue, numberOf(100), numberOf(100)})
+	if err != nil {
+		return Color{}, err
+	}
+	r, g, b := hslToRGB(v[0], clamp01(v[1]/100), clamp01(v[2]/100))
+	return Color{R: r, G: g, B: b}, nil
+}
+
+func parseHWBFunc(args []string) (Color, error) {
+	v, err := parseComponents(args, [3]func(component) (float64, error){hue, numberOf(100), numberOf(100)})
+	if err != nil {
+		return Color{}, err
+	}
+	r, g, b := hwbToRGB(v[0], clamp01(v[1]/100), clamp01(v[2]/100))
+	return Color{R: r, G: g, B: b}, nil
+}
+
+func parseLabFunc(args []string) (Color, error) {
+	v, err := parseComponents(args, [3]func(component) (float64, error){numberOf(100), numberOf(125), numberOf(125)})
+	if err != nil {
+		return Color{}, err
+	}
+	return fromFloatRGB(mixbox.LabToFloatRGB(mixbox.Lab{L: math.Max(v[0], 0), A: v[1], B: v[2]})), nil
+}
+
+func parseLCHFunc(args []string) (Color, error) {
+	v, err := parseComponents(args, [3]func(component) (float64, error){numberOf(100), numberOf(150), hue})
+	if err != nil {
+		return Color{}, err
+	}
+	a, b := fromPolar(math.Max(v[1], 0), v[2])
+	return fromFloatRGB(mixbox.LabToFloatRGB(mixbox.Lab{L: math.Max(v[0], 0), A: a, B: b})), nil
+}
+
+func parseOKLabFunc(args []string) (Color, error) {
+	v, err := parseComponents(args, [3]func(component) (float64, error){numberOf(1), numberOf(0.4), numberOf(0.4)})
+	if err != nil {
+		return Color{}, err
+	}
+	return fromFloatRGB(mixbox.OKLabToFloatRGB(mixbox.OKLab{L: math.Max(v[0], 0), A: v[1], B: v[2]})), nil
+}
+
+func parseOKLCHFunc(args []string) (Color, error) {
+	v, err := parseComponents(args, [3]func(component) (float64, error){numberOf(1), numberOf(0.4), hue})
+	if err != nil {
+		return Color{}, err
+	}
+	a, b := fromPolar(math.Max(v[1], 0), v[2])
+	return fromFloatRGB(mixbox.OKLabToFloatRGB(mixbox.OKLab{L: math.Max(v[0], 0), A: a, B: b})), nil
+}
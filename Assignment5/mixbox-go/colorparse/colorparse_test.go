@@ -0,0 +1,220 @@
+package colorparse
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	for _, tt := range []struct {
+		in   string
+		rgb  [3]uint8
+		a    float64
+		slop int // allowed 8-bit difference for the Lab-based syntaxes
+	}{
+		{"#f00", [3]uint8{255, 0, 0}, 1, 0},
+		{"#F008", [3]uint8{255, 0, 0}, 0x88 / 255.0, 0},
+		{"#feec00", [3]uint8{254, 236, 0}, 1, 0},
+		{"#FEEC0080", [3]uint8{254, 236, 0}, 0x80 / 255.0, 0},
+		{"  #190059  ", [3]uint8{25, 0, 89}, 1, 0},
+
+		{"rgb(254, 236, 0)", [3]uint8{254, 236, 0}, 1, 0},
+		{"rgb(254 236 0)", [3]uint8{254, 236, 0}, 1, 0},
+		{"RGB(100% 0% 50%)", [3]uint8{255, 0, 128}, 1, 0},
+		{"rgb(255 0 0 / 50%)", [3]uint8{255, 0, 0}, 0.5, 0},
+		{"rgba(255, 0, 0, 0.25)", [3]uint8{255, 0, 0}, 0.25, 0},
+		{"rgb(300 -5 none)", [3]uint8{255, 0, 0}, 1, 0},
+		{"rgb(0 0 0 / 2)", [3]uint8{0, 0, 0}, 1, 0},
+
+		{"hsl(120 100% 25%)", [3]uint8{0, 128, 0}, 1, 0},
+		{"hsl(0, 100%, 50%)", [3]uint8{255, 0, 0}, 1, 0},
+		{"hsla(240, 100%, 50%, 0.5)", [3]uint8{0, 0, 255}, 0.5, 0},
+		{"hsl(0.5turn 100% 50%)", [3]uint8{0, 255, 255}, 1, 0},
+		{"hsl(-120deg 100% 50%)", [3]uint8{0, 0, 255}, 1, 0},
+		{"hsl(3.14159265rad 100% 50%)", [3]uint8{0, 255, 255}, 1, 0},
+		{"hsl(200grad 100% 50%)", [3]uint8{0, 255, 255}, 1, 0},
+
+		{"hwb(0 0% 0%)", [3]uint8{255, 0, 0}, 1, 0},
+		{"hwb(120 20% 20%)", [3]uint8{51, 204, 51}, 1, 0},
+		{"hwb(0 60% 60%)", [3]uint8{128, 128, 128}, 1, 0},
+
+		{"lab(50% 0 0)", [3]uint8{119, 119, 119}, 1, 1},
+		{"lab(54.29 80.82 69.91)", [3]uint8{255, 0, 0}, 1, 1},
+		{"lch(54.29 106.84 40.85)", [3]uint8{255, 0, 0}, 1, 1},
+		{"lab(100 0 0 / 0.5)", [3]uint8{255, 255, 255}, 0.5, 1},
+		{"oklab(1 0 0)", [3]uint8{255, 255, 255}, 1, 1},
+		{"oklab(50% 0 0)", [3]uint8{99, 99, 99}, 1, 1},
+		{"oklch(0.62796 0.25768 29.23)", [3]uint8{255, 0, 0}, 1, 1},
+
+		{"rebeccapurple", [3]uint8{102, 51, 153}, 1, 0},
+		{" Red ", [3]uint8{255, 0, 0}, 1, 0},
+		{"transparent", [3]uint8{0, 0, 0}, 0, 0},
+	} {
+		c, err := Parse(tt.in)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tt.in, err)
+			continue
+		}
+		got := c.RGB()
+		for i := range got {
+			if d := int(got[i]) - int(tt.rgb[i]); d < -tt.slop || d > tt.slop {
+				t.Errorf("Parse(%q) = %v, want %v", tt.in, got, tt.rgb)
+				break
+			}
+		}
+		if math.Abs(c.A-tt.a) > 1e-9 {
+			t.Errorf("Parse(%q) alpha %v, want %v", tt.in, c.A, tt.a)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, tt := range []struct{ in, msg string }{
+		{"", "empty string"},
+		{"   ", "empty string"},
+		{"rgb(1 2 3", "missing closing parenthesis"},
+		{"rgbx(1 2 3)", "unknown function rgbx()"},
+		{"rgb(1 2)", "takes 3 components, got 2"},
+		{"rgb(1, 2, 3, 4, 5)", "takes 3 components, got 5"},
+		{"rgb(1, , 3)", "empty component"},
+		{"rgb(1 2 3 /)", "missing alpha"},
+		{"rgb(1 2 x)", `invalid value "x"`},
+		{"rgb(1 2 nan)", `invalid value "nan"`},
+		{"rgb(1 2 3deg)", "unexpected unit deg"},
+		{"rgb(1 2 3 / 1deg)", "alpha: unexpected unit deg"},
+		{"rgb(1 2 3 / half)", `alpha: invalid value "half"`},
+		{"hsl(10% 50% 50%)", "hue must be a number or angle"},
+		{"hsl(10 50deg 50%)", "unexpected unit deg"},
+		{"#12", "has 2 digits"},
+		{"#1234567", "has 7 digits"},
+		{"#ggg", "not hexadecimal"},
+		{"feec00", "hex colors start with '#'"},
+		{"fed", "hex colors start with '#'"},
+		{"bad", "hex colors start with '#'"},
+		{"add", "hex colors start with '#'"},
+		{"reddish", "not a hex color, color function or color name"},
+		{"PB29", "not a hex color, color function or color name"},
+	} {
+		_, err := Parse(tt.in)
+		var se *SyntaxError
+		if !errors.As(err, &se) {
+			t.Errorf("Parse(%q): err = %v, want a *SyntaxError", tt.in, err)
+			continue
+		}
+		if se.Input != tt.in || !strings.Contains(se.Msg, tt.msg) {
+			t.Errorf("Parse(%q): %v, want input %q and a message containing %q", tt.in, err, tt.in, tt.msg)
+		}
+	}
+	if _, err := ParseRGB("nope"); err == nil {
+		t.Error(`ParseRGB("nope") succeeded`)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error(`MustParse("nope") did not panic`)
+		}
+	}()
+	MustParse("nope")
+}
+
+func TestFormat(t *testing.T) {
+	c := MustParse("#feec00")
+	for _, tt := range []struct {
+		syntax Syntax
+		want   string
+	}{
+		{Hex, "#feec00"},
+		{RGB, "rgb(254 236 0)"},
+		{HSL, "hsl(55.75 100% 49.8%)"},
+		{Name, "#feec00"},
+	} {
+		if got := Format(c, tt.syntax); got != tt.want {
+			t.Errorf("Format(%v, %v) = %s, want %s", c, tt.syntax, got, tt.want)
+		}
+	}
+	if got := Format(MustParse("rgb(255 0 0 / 0.5)"), RGB); got != "rgb(255 0 0 / 0.5)" {
+		t.Errorf("translucent red as rgb = %s", got)
+	}
+	if got := MustParse("#ff000080").Hex(); got != "#ff000080" {
+		t.Errorf("translucent red as hex = %s", got)
+	}
+	for _, tt := range []struct{ in, want string }{
+		{"#808080", "gray"},
+		{"#00ffff", "aqua"},
+		{"rgb(0 0 0 / 0)", "transparent"},
+		{"rgb(0 0 0 / 0.5)", "#00000080"},
+	} {
+		if got := Format(MustParse(tt.in), Name); got != tt.want {
+			t.Errorf("Format(%s, Name) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+// Formatting in any syntax and parsing the result gives back the color.
+func TestFormatRoundTrip(t *testing.T) {
+	colors := []Color{
+		FromRGB([3]uint8{254, 236, 0}),
+		FromRGB([3]uint8{25, 0, 89}),
+		FromRGB([3]uint8{0, 0, 0}),
+		FromRGB([3]uint8{255, 255, 255}),
+		FromRGB([3]uint8{128, 128, 128}),
+		{R: 0.2, G: 0.6, B: 0.4, A: 0.5},
+	}
+	for _, c := range colors {
+		for s := Hex; s <= Name; s++ {
+			text := Format(c, s)
+			back, err := Parse(text)
+			if err != nil {
+				t.Errorf("Format(%v, %v) = %q does not parse: %v", c, s, text, err)
+				continue
+			}
+			want, got := c.NRGBA(), back.NRGBA()
+			for i, pair := range [][2]uint8{{want.R, got.R}, {want.G, got.G}, {want.B, got.B}, {want.A, got.A}} {
+				if d := int(pair[0]) - int(pair[1]); d < -1 || d > 1 {
+					t.Errorf("%v as %v is %q, which parses to %v (channel %d)", want, s, text, got, i)
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestSyntax(t *testing.T) {
+	for s := Hex; s <= Name; s++ {
+		got, err := ParseSyntax(s.String())
+		if err != nil || got != s {
+			t.Errorf("ParseSyntax(%q) = %v, %v", s.String(), got, err)
+		}
+	}
+	if _, err := ParseSyntax("cmyk"); err == nil {
+		t.Error(`ParseSyntax("cmyk") succeeded`)
+	}
+	if got := Syntax(42).String(); got != "Syntax(42)" {
+		t.Errorf("Syntax(42).String() = %s", got)
+	}
+}
+
+func TestNames(t *testing.T) {
+	names := Names()
+	if len(names) != len(namedColors)+1 {
+		t.Fatalf("%d names, want %d", len(names), len(namedColors)+1)
+	}
+	for i, name := range names {
+		if i > 0 && names[i-1] >= name {
+			t.Errorf("names out of order at %q", name)
+		}
+		if _, err := Parse(name); err != nil {
+			t.Errorf("named color %q does not parse: %v", name, err)
+		}
+	}
+}
+
+func TestInGamut(t *testing.T) {
+	if !MustParse("#feec00").InGamut() {
+		t.Error("#feec00 is reported outside sRGB")
+	}
+	if c := MustParse("oklch(0.9 0.4 145)"); c.InGamut() {
+		t.Errorf("oklch(0.9 0.4 145) = %+v is reported inside sRGB", c)
+	}
+}
@@ -0,0 +1,86 @@
+package colorparse
+
+import "math"
+
+// Conversions follow the sample code of CSS Color Level 4. sRGB values are
+// encoded. Lab and OKLab come from package mixbox; lab() and lch() use its
+// D50 white point.
+
+func hslToRGB(h, s, l float64) (r, g, b float64) {
+	f := func(n float64) float64 {
+		k := math.Mod(n+h/30, 12)
+		a := s * math.Min(l, 1-l)
+		return l - a*math.Max(-1, math.Min(k-3, math.Min(9-k, 1)))
+	}
+	return f(0), f(8), f(4)
+}
+
+// rgbToHSL returns hue in degrees and saturation and lightness in 0..1.
+// Achromatic colors get hue 0.
+func rgbToHSL(r, g, b float64) (h, s, l float64) {
+	hi := math.Max(r, math.Max(g, b))
+	lo := math.Min(r, math.Min(g, b))
+	l = (hi + lo) / 2
+	d := hi - lo
+	if d == 0 {
+		return 0, 0, l
+	}
+	if l > 0 && l < 1 {
+		s = d / math.Min(2*l, 2-2*l)
+	}
+	return hueOf(r, g, b, hi, d), s, l
+}
+
+func hueOf(r, g, b, hi, d float64) float64 {
+	var h float64
+	switch hi {
+	case r:
+		h = (g - b) / d
+		if g < b {
+			h += 6
+		}
+	case g:
+		h = (b-r)/d + 2
+	default:
+		h = (r-g)/d + 4
+	}
+	return h * 60
+}
+
+func hwbToRGB(h, w, bl float64) (r, g, b float64) {
+	if w+bl >= 1 {
+		gray := w / (w + bl)
+		return gray, gray, gray
+	}
+	r, g, b = hslToRGB(h, 1, 0.5)
+	scale := 1 - w - bl
+	return r*scale + w, g*scale + w, b*scale + w
+}
+
+func rgbToHWB(r, g, b float64) (h, w, bl float64) {
+	hi := math.Max(r, math.Max(g, b))
+	lo := math.Min(r, math.Min(g, b))
+	if d := hi - lo; d > 0 {
+		h = hueOf(r, g, b, hi, d)
+	}
+	return h, lo, 1 - hi
+}
+
+func fromPolar(c, h float64) (a, b float64) {
+	rad := h * math.Pi / 180
+	return c * math.Cos(rad), c * math.Sin(rad)
+}
+
+// toPolar returns chroma and hue in degrees; hue is 0 for negligible
+// chroma.
+func toPolar(a, b float64) (c, h float64) {
+	c = math.Hypot(a, b)
+	if c < 1e-9 {
+		return 0, 0
+	}
+	h = math.Atan2(b, a) * 180 / math.Pi
+	if h < 0 {
+		h += 360
+	}
+	return c, h
+}
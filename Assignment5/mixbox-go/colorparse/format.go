@@ -0,0 +1,152 @@
+package colorparse
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Syntax selects the notation Format writes.
+type Syntax int
+
+const (
+	Hex Syntax = iota
+	RGB
+	HSL
+	HWB
+	Lab
+	LCH
+	OKLab
+	OKLCH
+	// Name writes the color's CSS name, or hex if it has none.
+	Name
+)
+
+var syntaxNames = [...]string{"hex", "rgb", "hsl", "hwb", "lab", "lch", "oklab", "oklch", "name"}
+
+func (s Syntax) String() string {
+	if s < 0 || int(s) >= len(syntaxNames) {
+		return fmt.Sprintf("Syntax(%d)", int(s))
+	}
+	return syntaxNames[s]
+}
+
+// ParseSyntax returns the Syntax with the given name, as returned by
+// Syntax.String.
+func ParseSyntax(name string) (Syntax, error) {
+	for i, n := range syntaxNames {
+		if n == name {
+			return Syntax(i), nil
+		}
+	}
+	return 0, fmt.Errorf("colorparse: unknown syntax %q (want one of %s)", name, strings.Join(syntaxNames[:], ", "))
+}
+
+// Format writes c in the given syntax. Hex, RGB and Name write the color
+// clipped to 8-bit sRGB; the other syntaxes keep full precision. Alpha is
+// included only if the color is not opaque.
+func Format(c Color, syntax Syntax) string {
+	switch syntax {
+	case RGB:
+		rgb := c.RGB()
+		return fn("rgb", c.A, strconv.Itoa(int(rgb[0])), strconv.Itoa(int(rgb[1])), strconv.Itoa(int(rgb[2])))
+	case HSL:
+		h, s, l := rgbToHSL(c.R, c.G, c.B)
+		return fn("hsl", c.A, num(h, 2), num(s*100, 2)+"%", num(l*100, 2)+"%")
+	case HWB:
+		h, w, b := rgbToHWB(c.R, c.G, c.B)
+		return fn("hwb", c.A, num(h, 2), num(w*100, 2)+"%", num(b*100, 2)+"%")
+	case Lab:
+		lab := c.Lab()
+		return fn("lab", c.A, num(lab.L, 2), num(lab.A, 2), num(lab.B, 2))
+	case LCH:
+		lab := c.Lab()
+		ch, h := toPolar(lab.A, lab.B)
+		return fn("lch", c.A, num(lab.L, 2), num(ch, 2), num(h, 2))
+	case OKLab:
+		ok := c.OKLab()
+		return fn("oklab", c.A, num(ok.L, 4), num(ok.A, 4), num(ok.B, 4))
+	case OKLCH:
+		ok := c.OKLab()
+		ch, h := toPolar(ok.A, ok.B)
+		return fn("oklch", c.A, num(ok.L, 4), num(ch, 4), num(h, 2))
+	case Name:
+		if name, ok := c.Name(); ok {
+			return name
+		}
+	}
+	return c.Hex()
+}
+
+// String formats c as hex.
+func (c Color) String() string {
+	return c.Hex()
+}
+
+// Hex returns c as #rrggbb, or #rrggbbaa if it is not opaque.
+func (c Color) Hex() string {
+	n := c.NRGBA()
+	if n.A == 0xff {
+		return fmt.Sprintf("#%02x%02x%02x", n.R, n.G, n.B)
+	}
+	return fmt.Sprintf("#%02x%02x%02x%02x", n.R, n.G, n.B, n.A)
+}
+
+// Name returns the CSS name of c's 8-bit color, if it has one. Where
+// several names share a color (gray and grey, aqua and cyan) the
+// alphabetically first is returned. Fully transparent colors are named
+// "transparent".
+func (c Color) Name() (string, bool) {
+	n := c.NRGBA()
+	if n.A == 0 {
+		return "transparent", true
+	}
+	if n.A != 0xff {
+		return "", false
+	}
+	name, ok := colorNames[[3]uint8{n.R, n.G, n.B}]
+	return name, ok
+}
+
+// Names returns all CSS color names in alphabetical order.
+func Names() []string {
+	names := make([]string, 0, len(namedColors)+1)
+	for name := range namedColors {
+		names = append(names, name)
+	}
+	names = append(names, "transparent")
+	sort.Strings(names)
+	return names
+}
+
+// colorNames maps colors back to their first name.
+var colorNames = func() map[[3]uint8]string {
+	m := make(map[[3]uint8]string, len(namedColors))
+	for name, rgb := range namedColors {
+		if prev, ok := m[rgb]; !ok || name < prev {
+			m[rgb] = name
+		}
+	}
+	return m
+}()
+
+func fn(name string, alpha float64, args ...string) string {
+	s := name + "(" + strings.Join(args, " ")
+	if alpha < 1 {
+		s += " / " + num(alpha, 3)
+	}
+	return s + ")"
+}
+
+// num formats v with at most prec decimals and no trailing zeros.
+func num(v float64, prec int) string {
+	s := strconv.FormatFloat(v, 'f', prec, 64)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	if s == "-0" {
+		s = "0"
+	}
+	return s
+}
@@ -0,0 +1,35 @@
+package colorparse_test
+
+import (
+	"testing"
+
+	"github.com/timf34/mixbox-go/colorparse"
+	"github.com/timf34/mixbox-go/pigments"
+)
+
+// Pigment names and Color Index codes are not CSS colors; they are
+// resolved by package pigments, which falls back to colorparse.
+func TestPigmentNames(t *testing.T) {
+	for _, tt := range []struct {
+		in  string
+		rgb [3]uint8
+	}{
+		{"PB29", [3]uint8{25, 0, 89}},
+		{"cadmium-yellow", [3]uint8{254, 236, 0}},
+		{"Burnt Sienna", [3]uint8{123, 72, 0}},
+		{"#feec00", [3]uint8{254, 236, 0}},
+		{"hsl(0 100% 50%)", [3]uint8{255, 0, 0}},
+	} {
+		p, err := pigments.Builtin().Resolve(tt.in)
+		if err != nil {
+			t.Errorf("Resolve(%q): %v", tt.in, err)
+			continue
+		}
+		if p.RGB != tt.rgb {
+			t.Errorf("Resolve(%q) = %v, want %v", tt.in, p.RGB, tt.rgb)
+		}
+	}
+	if _, err := colorparse.Parse("PB29"); err == nil {
+		t.Error(`colorparse.Parse("PB29") succeeded`)
+	}
+}
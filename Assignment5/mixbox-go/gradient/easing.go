@@ -0,0 +1,100 @@
+package gradient
+
+import "math"
+
+// Easing maps the position between two stops, in 0..1, to the mixing ratio
+// of their colors. It should map 0 to 0 and 1 to 1.
+type Easing func(t float64) float64
+
+// Linear mixes in proportion to the distance between the stops.
+func Linear(t float64) float64 { return t }
+
+// Smoothstep eases in and out with the cubic 3t²-2t³.
+func Smoothstep(t float64) float64 { return t * t * (3 - 2*t) }
+
+// The CSS timing functions of the same names.
+var (
+	Ease      = CubicBezier(0.25, 0.1, 0.25, 1)
+	EaseIn    = CubicBezier(0.42, 0, 1, 1)
+	EaseOut   = CubicBezier(0, 0, 0.58, 1)
+	EaseInOut = CubicBezier(0.42, 0, 0.58, 1)
+)
+
+// CubicBezier returns the easing of a CSS cubic-bezier(x1, y1, x2, y2)
+// timing function. x1 and x2 are clamped to 0..1.
+func CubicBezier(x1, y1, x2, y2 float64) Easing {
+	x1 = math.Max(0, math.Min(1, x1))
+	x2 = math.Max(0, math.Min(1, x2))
+	// Coefficients of the polynomial form of each coordinate.
+	cx := 3 * x1
+	bx := 3*(x2-x1) - cx
+	ax := 1 - cx - bx
+	cy := 3 * y1
+	by := 3*(y2-y1) - cy
+	ay := 1 - cy - by
+
+	sampleX := func(s float64) float64 { return ((ax*s+bx)*s + cx) * s }
+	sampleY := func(s float64) float64 { return ((ay*s+by)*s + cy) * s }
+	slopeX := func(s float64) float64 { return (3*ax*s+2*bx)*s + cx }
+
+	return func(t float64) float64 {
+		if t <= 0 {
+			return 0
+		}
+		if t >= 1 {
+			return 1
+		}
+		// Newton's method converges quickly where the curve is not flat;
+		// bisection, which always works since x is monotonic, finishes up.
+		s := t
+		for i := 0; i < 8; i++ {
+			d := sampleX(s) - t
+			if math.Abs(d) < 1e-9 {
+				return sampleY(s)
+			}
+			slope := slopeX(s)
+			if math.Abs(slope) < 1e-6 {
+				break
+			}
+			s -= d / slope
+			if s < 0 || s > 1 {
+				break
+			}
+		}
+		lo, hi := 0.0, 1.0
+		s = t
+		for i := 0; i < 50; i++ {
+			x := sampleX(s)
+			if math.Abs(x-t) < 1e-9 {
+				break
+			}
+			if x < t {
+				lo = s
+			} else {
+				hi = s
+			}
+			s = (lo + hi) / 2
+		}
+		return sampleY(s)
+	}
+}
+
+// EasingByName returns the easing with the given CSS-style name: linear,
+// ease, ease-in, ease-out, ease-in-out or smoothstep.
+func EasingByName(name string) (Easing, bool) {
+	switch name {
+	case "linear":
+		return Linear, true
+	case "ease":
+		return Ease, true
+	case "ease-in":
+		return EaseIn, true
+	case "ease-out":
+		return EaseOut, true
+	case "ease-in-out":
+		return EaseInOut, true
+	case "smoothstep":
+		return Smoothstep, true
+	}
+	return nil, false
+}
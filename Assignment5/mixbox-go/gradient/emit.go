@@ -0,0 +1,217 @@
+package gradient
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"image"
+	"image/png"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Orientation is the direction in which a gradient runs across an image.
+type Orientation int
+
+const (
+	// Horizontal runs from left to right.
+	Horizontal Orientation = iota
+	// Vertical runs from top to bottom.
+	Vertical
+)
+
+func (o Orientation) String() string {
+	switch o {
+	case Horizontal:
+		return "horizontal"
+	case Vertical:
+		return "vertical"
+	}
+	return fmt.Sprintf("Orientation(%d)", int(o))
+}
+
+// Image renders the gradient left to right into a width×height image.
+func (g *Gradient) Image(width, height int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	g.Draw(img, img.Bounds(), Horizontal)
+	return img
+}
+
+// Draw renders the gradient into the rectangle r of dst, running in
+// direction o.
+func (g *Gradient) Draw(dst *image.NRGBA, r image.Rectangle, o Orientation) {
+	r = r.Intersect(dst.Bounds())
+	n := r.Dx()
+	if o == Vertical {
+		n = r.Dy()
+	}
+	if n > 0 {
+		DrawColors(dst, r, g.Sample(n), o)
+	}
+}
+
+// DrawColors fills the rectangle r of dst with opaque bands of colors, in
+// order, running in direction o. Given one color per column (Horizontal)
+// or row (Vertical) of r, it draws each in its own column or row, which
+// is how Draw renders a gradient; given fewer, the bands widen evenly.
+func DrawColors(dst *image.NRGBA, r image.Rectangle, colors [][3]uint8, o Orientation) {
+	r = r.Intersect(dst.Bounds())
+	if r.Empty() || len(colors) == 0 {
+		return
+	}
+	set := func(pix []byte, c [3]uint8) {
+		pix[0], pix[1], pix[2], pix[3] = c[0], c[1], c[2], 0xff
+	}
+	if o == Vertical {
+		for y := r.Min.Y; y < r.Max.Y; y++ {
+			c := colors[(y-r.Min.Y)*len(colors)/r.Dy()]
+			row := dst.Pix[dst.PixOffset(r.Min.X, y):dst.PixOffset(r.Max.X, y)]
+			for i := 0; i < len(row); i += 4 {
+				set(row[i:], c)
+			}
+		}
+		return
+	}
+	first := dst.Pix[dst.PixOffset(r.Min.X, r.Min.Y):dst.PixOffset(r.Max.X, r.Min.Y)]
+	for x := 0; x < r.Dx(); x++ {
+		set(first[4*x:], colors[x*len(colors)/r.Dx()])
+	}
+	for y := r.Min.Y + 1; y < r.Max.Y; y++ {
+		copy(dst.Pix[dst.PixOffset(r.Min.X, y):], first)
+	}
+}
+
+// WritePNG writes the gradient as a width×height PNG strip.
+func (g *Gradient) WritePNG(w io.Writer, width, height int) error {
+	return png.Encode(w, g.Image(width, height))
+}
+
+// FaithfulStops returns stops from which plain RGB interpolation, as done
+// by CSS and SVG, reproduces the gradient within its tolerance. They
+// include the gradient's own stops, plus as many intermediate ones as each
+// segment needs. There are always at least two.
+func (g *Gradient) FaithfulStops() []Stop {
+	if len(g.stops) == 1 {
+		c := g.At(0)
+		return []Stop{{0, c}, {1, c}}
+	}
+	var out []Stop
+	for i := 0; i+1 < len(g.stops); i++ {
+		a, b := g.stops[i].Pos, g.stops[i+1].Pos
+		if b == a {
+			continue // a hard edge; the neighbouring segments emit both sides
+		}
+		at := func(u float64) [3]uint8 { return g.mix(i, g.easing(u)).RGB() }
+		emit := func(u float64, c [3]uint8) {
+			s := Stop{a + u*(b-a), c}
+			if len(out) > 0 && out[len(out)-1] == s {
+				return // the end of the previous segment
+			}
+			out = append(out, s)
+		}
+		c0, c1 := at(0), at(1)
+		emit(0, c0)
+		g.subdivide(at, 0, 1, c0, c1, 0, emit)
+		emit(1, c1)
+	}
+	if len(out) == 0 {
+		// Every stop is at one position: a single hard edge.
+		last := len(g.stops) - 1
+		out = []Stop{{g.stops[0].Pos, g.stops[0].Color}, {g.stops[last].Pos, g.stops[last].Color}}
+	}
+	return out
+}
+
+// subdivide emits the interior stops needed between u0 and u1, in order.
+func (g *Gradient) subdivide(at func(float64) [3]uint8, u0, u1 float64, c0, c1 [3]uint8, depth int, emit func(float64, [3]uint8)) {
+	if depth >= maxDepth {
+		return
+	}
+	const probes = 8
+	worst := 0.0
+	for k := 1; k < probes; k++ {
+		f := float64(k) / probes
+		c := at(u0 + f*(u1-u0))
+		for ch := range c {
+			want := float64(c0[ch]) + f*(float64(c1[ch])-float64(c0[ch]))
+			worst = math.Max(worst, math.Abs(float64(c[ch])-want))
+		}
+	}
+	if worst <= g.tolerance {
+		return
+	}
+	um := (u0 + u1) / 2
+	cm := at(um)
+	g.subdivide(at, u0, um, c0, cm, depth+1, emit)
+	emit(um, cm)
+	g.subdivide(at, um, u1, cm, c1, depth+1, emit)
+}
+
+// CSS returns a CSS linear-gradient() value for the gradient. direction is
+// an angle or side such as "90deg" or "to right"; if empty it is omitted and
+// CSS draws top to bottom.
+func (g *Gradient) CSS(direction string) string {
+	var sb strings.Builder
+	sb.WriteString("linear-gradient(")
+	if direction != "" {
+		sb.WriteString(direction)
+		sb.WriteString(", ")
+	}
+	for i, s := range g.FaithfulStops() {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		fmt.Fprintf(&sb, "%s %s%%", hex(s.Color), formatPercent(s.Pos))
+	}
+	sb.WriteString(")")
+	return sb.String()
+}
+
+// SVG returns an SVG <linearGradient> element with the given id, running
+// left to right across the bounding box of the shape it fills.
+func (g *Gradient) SVG(id string) string {
+	return g.svg(id, Horizontal)
+}
+
+func (g *Gradient) svg(id string, o Orientation) string {
+	x2, y2 := 1, 0
+	if o == Vertical {
+		x2, y2 = 0, 1
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "<linearGradient id=\"%s\" x1=\"0\" y1=\"0\" x2=\"%d\" y2=\"%d\">\n", html.EscapeString(id), x2, y2)
+	for _, s := range g.FaithfulStops() {
+		fmt.Fprintf(&sb, "  <stop offset=\"%s%%\" stop-color=\"%s\"/>\n", formatPercent(s.Pos), hex(s.Color))
+	}
+	sb.WriteString("</linearGradient>")
+	return sb.String()
+}
+
+// WriteSVG writes a standalone width×height SVG image filled with the
+// gradient, running left to right.
+func (g *Gradient) WriteSVG(w io.Writer, width, height int) error {
+	return g.WriteSVGOriented(w, width, height, Horizontal)
+}
+
+// WriteSVGOriented is like WriteSVG but runs the gradient in direction o.
+func (g *Gradient) WriteSVGOriented(w io.Writer, width, height int, o Orientation) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%d\" height=\"%d\" viewBox=\"0 0 %d %d\">\n", width, height, width, height)
+	fmt.Fprintf(bw, "<defs>\n%s\n</defs>\n", g.svg("mixbox", o))
+	fmt.Fprintf(bw, "<rect width=\"%d\" height=\"%d\" fill=\"url(#mixbox)\"/>\n</svg>\n", width, height)
+	return bw.Flush()
+}
+
+func hex(c [3]uint8) string {
+	return fmt.Sprintf("#%02x%02x%02x", c[0], c[1], c[2])
+}
+
+// formatPercent formats a position in 0..1 as a percentage with at most
+// three decimals.
+func formatPercent(pos float64) string {
+	s := strconv.FormatFloat(pos*100, 'f', 3, 64)
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
@@ -0,0 +1,222 @@
+package gradient
+
+import (
+	"bytes"
+	"encoding/xml"
+	"image"
+	"image/png"
+	"math"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// rgbAt interpolates the stops in RGB the way CSS and SVG do.
+func rgbAt(stops []Stop, t float64) [3]float64 {
+	if t <= stops[0].Pos {
+		return float3(stops[0].Color)
+	}
+	for i := 1; i < len(stops); i++ {
+		a, b := stops[i-1], stops[i]
+		if t < b.Pos {
+			f := (t - a.Pos) / (b.Pos - a.Pos)
+			var c [3]float64
+			for ch := range c {
+				c[ch] = float64(a.Color[ch]) + f*(float64(b.Color[ch])-float64(a.Color[ch]))
+			}
+			return c
+		}
+	}
+	return float3(stops[len(stops)-1].Color)
+}
+
+func float3(c [3]uint8) [3]float64 {
+	return [3]float64{float64(c[0]), float64(c[1]), float64(c[2])}
+}
+
+func TestFaithfulStops(t *testing.T) {
+	testMixer(t)
+	for _, tt := range []struct {
+		name  string
+		stops []Stop
+		opts  []Option
+	}{
+		{"two colors", []Stop{{0, yellow}, {1, blue}}, nil},
+		{"three colors", []Stop{{0, red}, {0.3, yellow}, {1, blue}}, nil},
+		{"eased", []Stop{{0, yellow}, {1, blue}}, []Option{WithEasing(EaseInOut)}},
+		{"loose", []Stop{{0, yellow}, {1, blue}}, []Option{WithTolerance(8)}},
+		{"hard edge", []Stop{{0, yellow}, {0.5, red}, {0.5, blue}, {1, white}}, nil},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			g := mustNew(t, tt.stops, tt.opts...)
+			stops := g.FaithfulStops()
+			if first, last := stops[0], stops[len(stops)-1]; first != tt.stops[0] || last != tt.stops[len(tt.stops)-1] {
+				t.Errorf("faithful stops run from %v to %v, want %v to %v", first, last, tt.stops[0], tt.stops[len(tt.stops)-1])
+			}
+			for i := 1; i < len(stops); i++ {
+				if stops[i].Pos < stops[i-1].Pos {
+					t.Fatalf("stop %d at %v comes before stop %d at %v", i, stops[i].Pos, i-1, stops[i-1].Pos)
+				}
+			}
+			// Between the probes of the subdivision the error can exceed
+			// the tolerance slightly; it must not exceed it by much.
+			limit := g.tolerance + 2
+			for i := 0; i <= 1000; i++ {
+				pos := float64(i) / 1000
+				got, want := rgbAt(stops, pos), g.At(pos)
+				for ch := range got {
+					if d := math.Abs(got[ch] - float64(want[ch])); d > limit {
+						t.Fatalf("at %v RGB interpolation gives %v, the gradient %v", pos, got, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+// A tighter tolerance needs more stops.
+func TestFaithfulStopsTolerance(t *testing.T) {
+	testMixer(t)
+	stops := []Stop{{0, yellow}, {1, blue}}
+	loose := len(mustNew(t, stops, WithTolerance(16)).FaithfulStops())
+	tight := len(mustNew(t, stops, WithTolerance(0.5)).FaithfulStops())
+	if loose >= tight {
+		t.Errorf("tolerance 16 needs %d stops, 0.5 needs %d", loose, tight)
+	}
+}
+
+func TestCSS(t *testing.T) {
+	testMixer(t)
+	g := mustNew(t, []Stop{{0, yellow}, {1, blue}})
+	css := g.CSS("to right")
+	if !strings.HasPrefix(css, "linear-gradient(to right, #feec00 0%, ") || !strings.HasSuffix(css, ", #190059 100%)") {
+		t.Errorf("CSS = %s", css)
+	}
+	stop := regexp.MustCompile(`^#[0-9a-f]{6} \d+(\.\d{1,3})?%$`)
+	items := strings.Split(strings.TrimSuffix(strings.TrimPrefix(css, "linear-gradient("), ")"), ", ")
+	if len(items) != len(g.FaithfulStops())+1 {
+		t.Errorf("CSS has %d items, want the direction and %d stops", len(items), len(g.FaithfulStops()))
+	}
+	for _, item := range items[1:] {
+		if !stop.MatchString(item) {
+			t.Errorf("malformed CSS stop %q", item)
+		}
+	}
+	if css := g.CSS(""); !strings.HasPrefix(css, "linear-gradient(#feec00 0%, ") {
+		t.Errorf("CSS without a direction = %s", css)
+	}
+}
+
+func TestCSSDegenerate(t *testing.T) {
+	testMixer(t)
+	for _, tt := range []struct {
+		stops []Stop
+		want  string
+	}{
+		{[]Stop{{0.5, red}, {0.5, blue}}, "linear-gradient(#ff2702 50%, #190059 50%)"},
+		{[]Stop{{0, red}, {0, yellow}, {0, blue}}, "linear-gradient(#ff2702 0%, #190059 0%)"},
+		{[]Stop{{0.25, red}}, "linear-gradient(#ff2702 0%, #ff2702 100%)"},
+	} {
+		if got := mustNew(t, tt.stops).CSS(""); got != tt.want {
+			t.Errorf("stops %v: CSS = %s, want %s", tt.stops, got, tt.want)
+		}
+	}
+}
+
+func TestSVG(t *testing.T) {
+	testMixer(t)
+	g := mustNew(t, []Stop{{0, yellow}, {1, blue}})
+	var elem struct {
+		ID    string `xml:"id,attr"`
+		X2    string `xml:"x2,attr"`
+		Y2    string `xml:"y2,attr"`
+		Stops []struct {
+			Offset string `xml:"offset,attr"`
+			Color  string `xml:"stop-color,attr"`
+		} `xml:"stop"`
+	}
+	if err := xml.Unmarshal([]byte(g.SVG(`a"b<c`)), &elem); err != nil {
+		t.Fatalf("SVG is not well-formed: %v", err)
+	}
+	if elem.ID != `a"b<c` || elem.X2 != "1" || elem.Y2 != "0" {
+		t.Errorf("id %q, x2 %s, y2 %s", elem.ID, elem.X2, elem.Y2)
+	}
+	faithful := g.FaithfulStops()
+	if len(elem.Stops) != len(faithful) {
+		t.Fatalf("%d SVG stops, want %d", len(elem.Stops), len(faithful))
+	}
+	for i, s := range elem.Stops {
+		if want := hex(faithful[i].Color); s.Color != want || s.Offset != formatPercent(faithful[i].Pos)+"%" {
+			t.Errorf("stop %d: offset %s color %s, want %s%% %s", i, s.Offset, s.Color, formatPercent(faithful[i].Pos), want)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := g.WriteSVGOriented(&buf, 40, 300, Vertical); err != nil {
+		t.Fatal(err)
+	}
+	var doc struct {
+		Width  string `xml:"width,attr"`
+		Height string `xml:"height,attr"`
+		Defs   struct {
+			Gradient struct {
+				X2 string `xml:"x2,attr"`
+				Y2 string `xml:"y2,attr"`
+			} `xml:"linearGradient"`
+		} `xml:"defs"`
+	}
+	if err := xml.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("SVG document is not well-formed: %v", err)
+	}
+	if doc.Width != "40" || doc.Height != "300" || doc.Defs.Gradient.X2 != "0" || doc.Defs.Gradient.Y2 != "1" {
+		t.Errorf("vertical SVG: %+v", doc)
+	}
+}
+
+func TestWritePNG(t *testing.T) {
+	testMixer(t)
+	g := mustNew(t, []Stop{{0, yellow}, {1, blue}})
+	var buf bytes.Buffer
+	if err := g.WritePNG(&buf, 64, 3); err != nil {
+		t.Fatal(err)
+	}
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if img.Bounds() != image.Rect(0, 0, 64, 3) {
+		t.Fatalf("bounds %v", img.Bounds())
+	}
+	want := g.Sample(64)
+	for y := 0; y < 3; y++ {
+		for x := 0; x < 64; x++ {
+			r, gr, b, a := img.At(x, y).RGBA()
+			if got := [3]uint8{uint8(r >> 8), uint8(gr >> 8), uint8(b >> 8)}; got != want[x] || a != 0xffff {
+				t.Fatalf("pixel (%d, %d) = %v alpha %#x, want %v opaque", x, y, got, a, want[x])
+			}
+		}
+	}
+}
+
+func TestDrawVertical(t *testing.T) {
+	testMixer(t)
+	g := mustNew(t, []Stop{{0, yellow}, {1, blue}})
+	dst := image.NewNRGBA(image.Rect(0, 0, 10, 20))
+	r := image.Rect(2, 5, 6, 15)
+	g.Draw(dst, r, Vertical)
+	want := g.Sample(r.Dy())
+	for y := 0; y < 20; y++ {
+		for x := 0; x < 10; x++ {
+			c := dst.NRGBAAt(x, y)
+			if !image.Pt(x, y).In(r) {
+				if c.A != 0 {
+					t.Fatalf("pixel (%d, %d) outside the rectangle was drawn", x, y)
+				}
+				continue
+			}
+			if got := [3]uint8{c.R, c.G, c.B}; got != want[y-r.Min.Y] {
+				t.Fatalf("pixel (%d, %d) = %v, want %v", x, y, got, want[y-r.Min.Y])
+			}
+		}
+	}
+}
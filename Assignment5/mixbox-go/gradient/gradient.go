@@ -0,0 +1,187 @@
+// Package gradient builds multi-stop color gradients whose colors are mixed
+// as pigments with Mixbox, and renders them as PNG strips, CSS
+// linear-gradient values and SVG linearGradient elements.
+//
+// Between two adjacent stops the colors are mixed in latent space, so a
+// yellow-to-blue gradient passes through green rather than gray. CSS and SVG
+// can only interpolate in RGB, so their emitters add intermediate stops
+// until the RGB interpolation stays within a tolerance of the pigment mix.
+package gradient
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/timf34/mixbox-go/mixbox"
+)
+
+// Stop places a color at a position in 0..1 along the gradient.
+type Stop struct {
+	Pos   float64
+	Color [3]uint8
+}
+
+// Gradient is an immutable multi-stop gradient. It is safe for concurrent
+// use.
+type Gradient struct {
+	stops     []Stop
+	latents   []mixbox.Latent
+	mixer     *mixbox.Mixer
+	easing    Easing
+	tolerance float64
+}
+
+// Option configures a Gradient.
+type Option func(*Gradient) error
+
+// DefaultTolerance is the largest difference, in 8-bit steps per channel,
+// the CSS and SVG emitters allow between RGB interpolation and the pigment
+// mix.
+const DefaultTolerance = 1.0
+
+// maxDepth bounds the subdivision of a segment when emitting stops, so a
+// segment is split into at most 1<<maxDepth intervals.
+const maxDepth = 10
+
+var (
+	// ErrNoStops is returned when a gradient has no color stops.
+	ErrNoStops = errors.New("gradient: no color stops")
+	// ErrStopOrder is returned when stop positions decrease or fall outside
+	// 0..1.
+	ErrStopOrder = errors.New("gradient: stop positions must be in 0..1 and non-decreasing")
+)
+
+// WithMixer mixes the gradient's colors with m instead of the default
+// Mixer.
+func WithMixer(m *mixbox.Mixer) Option {
+	return func(g *Gradient) error {
+		if m == nil {
+			return errors.New("gradient: nil Mixer")
+		}
+		g.mixer = m
+		return nil
+	}
+}
+
+// WithEasing reshapes the mixing ratio between each pair of adjacent stops.
+func WithEasing(e Easing) Option {
+	return func(g *Gradient) error {
+		if e == nil {
+			return errors.New("gradient: nil Easing")
+		}
+		g.easing = e
+		return nil
+	}
+}
+
+// WithTolerance sets the tolerance of the CSS and SVG emitters in 8-bit
+// steps; see DefaultTolerance.
+func WithTolerance(steps float64) Option {
+	return func(g *Gradient) error {
+		if !(steps > 0) {
+			return fmt.Errorf("gradient: tolerance must be positive, got %v", steps)
+		}
+		g.tolerance = steps
+		return nil
+	}
+}
+
+// New returns a gradient through stops. Positions must be in 0..1 and
+// non-decreasing; two stops at the same position make a hard edge. Before
+// the first stop and after the last the gradient is flat.
+func New(stops []Stop, opts ...Option) (*Gradient, error) {
+	if len(stops) == 0 {
+		return nil, ErrNoStops
+	}
+	for i, s := range stops {
+		if math.IsNaN(s.Pos) || s.Pos < 0 || s.Pos > 1 || (i > 0 && s.Pos < stops[i-1].Pos) {
+			return nil, fmt.Errorf("%w: stop %d at %v", ErrStopOrder, i, s.Pos)
+		}
+	}
+	g := &Gradient{
+		stops:     append([]Stop(nil), stops...),
+		easing:    Linear,
+		tolerance: DefaultTolerance,
+	}
+	for _, opt := range opts {
+		if err := opt(g); err != nil {
+			return nil, err
+		}
+	}
+	if g.mixer == nil {
+		g.mixer = mixbox.Default()
+	}
+	g.latents = make([]mixbox.Latent, len(stops))
+	for i, s := range stops {
+		g.latents[i] = g.mixer.RGBToLatent(s.Color)
+	}
+	return g, nil
+}
+
+// Even returns a gradient with colors spaced evenly from 0 to 1.
+func Even(colors [][3]uint8, opts ...Option) (*Gradient, error) {
+	stops := make([]Stop, len(colors))
+	for i, c := range colors {
+		if len(colors) > 1 {
+			stops[i].Pos = float64(i) / float64(len(colors)-1)
+		}
+		stops[i].Color = c
+	}
+	return New(stops, opts...)
+}
+
+// Stops returns a copy of the gradient's stops.
+func (g *Gradient) Stops() []Stop {
+	return append([]Stop(nil), g.stops...)
+}
+
+// segment returns the index i of the stop pair (i, i+1) covering t and the
+// eased mixing ratio within it. If t lies outside the stops, or the
+// gradient has a single stop, it returns that end stop with ratio 0.
+func (g *Gradient) segment(t float64) (int, float64) {
+	last := len(g.stops) - 1
+	if last == 0 || t <= g.stops[0].Pos {
+		return 0, 0
+	}
+	if t >= g.stops[last].Pos {
+		return last, 0
+	}
+	i := 0
+	for i+1 < last && t >= g.stops[i+1].Pos {
+		i++
+	}
+	a, b := g.stops[i].Pos, g.stops[i+1].Pos
+	return i, g.easing((t - a) / (b - a))
+}
+
+// mix returns the latent at ratio u between stops i and i+1.
+func (g *Gradient) mix(i int, u float64) mixbox.Latent {
+	if u == 0 || i+1 == len(g.stops) {
+		return g.latents[i]
+	}
+	return g.latents[i].Lerp(g.latents[i+1], u)
+}
+
+// LatentAt returns the gradient's latent at position t.
+func (g *Gradient) LatentAt(t float64) mixbox.Latent {
+	return g.mix(g.segment(t))
+}
+
+// At returns the gradient's color at position t.
+func (g *Gradient) At(t float64) [3]uint8 {
+	return g.LatentAt(t).RGB()
+}
+
+// Sample returns n colors evenly spaced from 0 to 1, both ends included.
+func (g *Gradient) Sample(n int) [][3]uint8 {
+	colors := make([][3]uint8, max(n, 0))
+	for i := range colors {
+		t := 0.0
+		if n > 1 {
+			t = float64(i) / float64(n-1)
+		}
+		colors[i] = g.At(t)
+	}
+	return colors
+}
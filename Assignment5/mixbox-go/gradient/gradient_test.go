@@ -0,0 +1,217 @@
+package gradient
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/timf34/mixbox-go/mixbox"
+)
+
+var (
+	yellow = [3]uint8{254, 236, 0}
+	blue   = [3]uint8{25, 0, 89}
+	red    = [3]uint8{255, 39, 2}
+	white  = [3]uint8{255, 255, 255}
+)
+
+// testMixer returns the default Mixer, skipping the test when the mixbox
+// package was built without an embedded table.
+func testMixer(t *testing.T) *mixbox.Mixer {
+	t.Helper()
+	m, ok := mixbox.DefaultOK()
+	if !ok {
+		t.Skip("built without an embedded LUT")
+	}
+	return m
+}
+
+func mustNew(t *testing.T, stops []Stop, opts ...Option) *Gradient {
+	t.Helper()
+	g, err := New(stops, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func TestAt(t *testing.T) {
+	m := testMixer(t)
+	g := mustNew(t, []Stop{{0.2, yellow}, {0.6, blue}, {1, white}})
+	for _, tt := range []struct {
+		t    float64
+		want [3]uint8
+	}{
+		{-1, yellow},
+		{0, yellow},
+		{0.2, yellow},
+		{0.3, m.Lerp(yellow, blue, 0.25)},
+		{0.4, m.Lerp(yellow, blue, 0.5)},
+		{0.6, blue},
+		{0.7, m.Lerp(blue, white, 0.25)},
+		{1, white},
+		{2, white},
+	} {
+		if got := g.At(tt.t); got != tt.want {
+			t.Errorf("At(%v) = %v, want %v", tt.t, got, tt.want)
+		}
+	}
+}
+
+func TestSample(t *testing.T) {
+	testMixer(t)
+	g := mustNew(t, []Stop{{0, yellow}, {1, blue}})
+	colors := g.Sample(5)
+	if len(colors) != 5 {
+		t.Fatalf("Sample(5) returned %d colors", len(colors))
+	}
+	for i, c := range colors {
+		if want := g.At(float64(i) / 4); c != want {
+			t.Errorf("Sample(5)[%d] = %v, want At(%v) = %v", i, c, float64(i)/4, want)
+		}
+	}
+	if got := g.Sample(1); len(got) != 1 || got[0] != yellow {
+		t.Errorf("Sample(1) = %v, want [%v]", got, yellow)
+	}
+	for _, n := range []int{0, -3} {
+		if got := g.Sample(n); len(got) != 0 {
+			t.Errorf("Sample(%d) = %v, want none", n, got)
+		}
+	}
+}
+
+func TestSingleStop(t *testing.T) {
+	testMixer(t)
+	g := mustNew(t, []Stop{{0.3, red}})
+	for _, pos := range []float64{0, 0.3, 1} {
+		if got := g.At(pos); got != red {
+			t.Errorf("At(%v) = %v, want %v", pos, got, red)
+		}
+	}
+	g, err := Even([][3]uint8{red})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := g.Stops(); len(got) != 1 || got[0] != (Stop{0, red}) {
+		t.Errorf("Even with one color: stops %v", got)
+	}
+}
+
+func TestHardEdge(t *testing.T) {
+	m := testMixer(t)
+	g := mustNew(t, []Stop{{0, yellow}, {0.5, red}, {0.5, blue}, {1, white}})
+	for _, tt := range []struct {
+		t    float64
+		want [3]uint8
+	}{
+		{0.25, m.Lerp(yellow, red, 0.5)},
+		{0.499, m.Lerp(yellow, red, 0.998)},
+		{0.5, blue},
+		{0.501, m.Lerp(blue, white, 0.002)},
+		{0.75, m.Lerp(blue, white, 0.5)},
+	} {
+		if got := g.At(tt.t); got != tt.want {
+			t.Errorf("At(%v) = %v, want %v", tt.t, got, tt.want)
+		}
+	}
+}
+
+func TestEasing(t *testing.T) {
+	m := testMixer(t)
+	for name, e := range map[string]Easing{"smoothstep": Smoothstep, "ease-in": EaseIn} {
+		g := mustNew(t, []Stop{{0, yellow}, {1, blue}}, WithEasing(e))
+		for _, pos := range []float64{0.1, 0.25, 0.5, 0.9} {
+			if got, want := g.At(pos), m.Lerp(yellow, blue, e(pos)); got != want {
+				t.Errorf("%s: At(%v) = %v, want %v", name, pos, got, want)
+			}
+		}
+	}
+}
+
+func TestEasingByName(t *testing.T) {
+	for _, name := range []string{"linear", "ease", "ease-in", "ease-out", "ease-in-out", "smoothstep"} {
+		e, ok := EasingByName(name)
+		if !ok {
+			t.Errorf("EasingByName(%q) not found", name)
+			continue
+		}
+		if e(0) != 0 || e(1) != 1 {
+			t.Errorf("%s maps 0 and 1 to %v and %v", name, e(0), e(1))
+		}
+		prev := 0.0
+		for i := 1; i <= 100; i++ {
+			v := e(float64(i) / 100)
+			if v < prev-1e-9 {
+				t.Errorf("%s decreases at %v: %v after %v", name, float64(i)/100, v, prev)
+				break
+			}
+			prev = v
+		}
+	}
+	if _, ok := EasingByName("bouncy"); ok {
+		t.Error(`EasingByName("bouncy") found an easing`)
+	}
+}
+
+func TestCubicBezier(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		e       Easing
+		t, want float64
+	}{
+		{"linear curve", CubicBezier(0, 0, 1, 1), 0.3, 0.3},
+		{"linear curve", CubicBezier(0.25, 0.25, 0.75, 0.75), 0.7, 0.7},
+		// The CSS keywords, checked against bisection of the Bézier curve.
+		{"ease", Ease, 0.5, 0.8024033876},
+		{"ease-in-out", EaseInOut, 0.5, 0.5},
+		{"ease-in", EaseIn, 0.25, 0.0934646507},
+		{"clamped x", CubicBezier(-1, 0, 2, 1), 0.5, 0.5},
+		{"below 0", Ease, -0.5, 0},
+		{"above 1", Ease, 1.5, 1},
+	} {
+		if got := tt.e(tt.t); math.Abs(got-tt.want) > 1e-6 {
+			t.Errorf("%s(%v) = %v, want %v", tt.name, tt.t, got, tt.want)
+		}
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	testMixer(t)
+	if _, err := New(nil); !errors.Is(err, ErrNoStops) {
+		t.Errorf("no stops: err = %v, want ErrNoStops", err)
+	}
+	for _, stops := range [][]Stop{
+		{{0.5, red}, {0.4, blue}},
+		{{-0.1, red}},
+		{{0, red}, {1.1, blue}},
+		{{math.NaN(), red}},
+	} {
+		if _, err := New(stops); !errors.Is(err, ErrStopOrder) {
+			t.Errorf("stops %v: err = %v, want ErrStopOrder", stops, err)
+		}
+	}
+	stops := []Stop{{0, red}, {1, blue}}
+	for name, opt := range map[string]Option{
+		"nil Mixer":      WithMixer(nil),
+		"nil Easing":     WithEasing(nil),
+		"zero tolerance": WithTolerance(0),
+		"NaN tolerance":  WithTolerance(math.NaN()),
+	} {
+		if _, err := New(stops, opt); err == nil {
+			t.Errorf("%s: New succeeded", name)
+		}
+	}
+}
+
+// New copies its stops, and Stops returns a copy, so the caller cannot
+// change a Gradient after the fact.
+func TestStopsCopied(t *testing.T) {
+	testMixer(t)
+	stops := []Stop{{0, red}, {1, blue}}
+	g := mustNew(t, stops)
+	stops[0].Color = white
+	g.Stops()[1].Color = white
+	if got := g.Stops(); got[0].Color != red || got[1].Color != blue {
+		t.Errorf("stops changed to %v", got)
+	}
+}
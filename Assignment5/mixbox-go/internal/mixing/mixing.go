@@ -0,0 +1,81 @@
+// Package mixing holds what the module's HTTP handlers, in packages api,
+// live and render, have in common: the Mixer and palette they mix with and
+// the limits on what a single request may ask for.
+package mixing
+
+import (
+	"errors"
+
+	"github.com/timf34/mixbox-go/mixbox"
+	"github.com/timf34/mixbox-go/pigments"
+)
+
+// Limits on requests.
+const (
+	// MaxColors is the most colors a mix or gradient may have.
+	MaxColors = 16
+	// MaxStops is the most stops a gradient may be sampled at.
+	MaxStops = 256
+	// DefaultStops is the number of stops a gradient is sampled at when
+	// the request does not say.
+	DefaultStops = 11
+)
+
+// Errors returned by the setters; handlers prefix them with their package
+// name.
+var (
+	ErrNilMixer   = errors.New("nil Mixer")
+	ErrNilPalette = errors.New("nil Palette")
+)
+
+// Source is the Mixer and palette a handler mixes with. Either may be left
+// unset, in which case the current default is used, so that
+// mixbox.SetDefault and pigments.SetDefault take effect on a running
+// server. The zero value uses both defaults.
+type Source struct {
+	mixer   *mixbox.Mixer
+	palette *pigments.Palette
+}
+
+// SetMixer makes s mix with m instead of the default Mixer.
+func (s *Source) SetMixer(m *mixbox.Mixer) error {
+	if m == nil {
+		return ErrNilMixer
+	}
+	s.mixer = m
+	return nil
+}
+
+// SetPalette makes s resolve pigment names and codes in p instead of the
+// default palette.
+func (s *Source) SetPalette(p *pigments.Palette) error {
+	if p == nil {
+		return ErrNilPalette
+	}
+	s.palette = p
+	return nil
+}
+
+// Mixer returns the Mixer set by SetMixer, or the current default Mixer.
+func (s *Source) Mixer() *mixbox.Mixer {
+	if s.mixer != nil {
+		return s.mixer
+	}
+	return mixbox.Default()
+}
+
+// Palette returns the palette set by SetPalette, or the current default
+// palette.
+func (s *Source) Palette() *pigments.Palette {
+	if s.palette != nil {
+		return s.palette
+	}
+	return pigments.Default()
+}
+
+// Snapshot returns a Source bound to the Mixer and palette s uses now, so
+// that a request sees one version of each even if the defaults are
+// replaced while it runs.
+func (s *Source) Snapshot() Source {
+	return Source{s.Mixer(), s.Palette()}
+}
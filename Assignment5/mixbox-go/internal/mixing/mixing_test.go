@@ -0,0 +1,46 @@
+package mixing
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/timf34/mixbox-go/mixbox"
+	"github.com/timf34/mixbox-go/pigments"
+)
+
+func TestSource(t *testing.T) {
+	var s Source
+	if s.Palette() != pigments.Default() {
+		t.Error("the zero Source does not use the default palette")
+	}
+	if err := s.SetMixer(nil); !errors.Is(err, ErrNilMixer) {
+		t.Errorf("SetMixer(nil) = %v", err)
+	}
+	if err := s.SetPalette(nil); !errors.Is(err, ErrNilPalette) {
+		t.Errorf("SetPalette(nil) = %v", err)
+	}
+
+	custom := pigments.MustPalette("custom", []pigments.Pigment{pigments.CadmiumYellow})
+	if err := s.SetPalette(custom); err != nil {
+		t.Fatal(err)
+	}
+	if s.Palette() != custom {
+		t.Error("SetPalette did not replace the default palette")
+	}
+
+	m, ok := mixbox.DefaultOK()
+	if !ok {
+		t.Skip("built without an embedded LUT")
+	}
+	var def Source
+	snap := def.Snapshot()
+	if snap.Mixer() != m || snap.Palette() != pigments.Default() {
+		t.Error("Snapshot did not bind the defaults")
+	}
+	// A snapshot keeps its palette after the default changes.
+	pigments.SetDefault(custom)
+	defer pigments.SetDefault(pigments.Builtin())
+	if snap.Palette() != pigments.Builtin() || def.Palette() != custom {
+		t.Error("Snapshot followed a later SetDefault")
+	}
+}
@@ -0,0 +1,359 @@
+// Package live streams pigment mixes to a browser over a WebSocket, so a
+// page can follow a slider without an HTTP round trip per input event.
+//
+// The client sends its whole state as a JSON text message whenever it
+// changes:
+//
+//	{"seq": 7, "colors": ["#feec00", "ultramarine blue"], "ratio": 0.25, "stops": 11}
+//
+// and the server answers with the color mixed at ratio along an even
+// gradient through the colors, the RGB interpolation at the same ratio for
+// comparison, and the gradient's stops:
+//
+//	{"seq": 7, "color": "#…", "linearColor": "#…", "stops": [{"position": 0, "color": "#feec00"}, …], "css": "linear-gradient(…)", "coalesced": 3}
+//
+// An invalid state is answered with {"seq": 7, "error": "…"} and the
+// connection stays open.
+//
+// Updates are coalesced per connection: the server only ever computes the
+// newest state it has received, and states that arrive while it is busy
+// replace each other rather than queue. A handler-wide limit on concurrent
+// computations means that under load each connection simply skips more
+// intermediate states. coalesced counts the states skipped since the
+// previous answer.
+package live
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"runtime"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/timf34/mixbox-go/colorparse"
+	"github.com/timf34/mixbox-go/gradient"
+	"github.com/timf34/mixbox-go/internal/mixing"
+	"github.com/timf34/mixbox-go/mixbox"
+	"github.com/timf34/mixbox-go/pigments"
+)
+
+// DefaultMaxMessageBytes is the default limit on the size of a client
+// message; see WithMaxMessageBytes.
+const DefaultMaxMessageBytes = 16 << 10
+
+// Connection timing.
+const (
+	// pingInterval is how often the server pings an idle client.
+	pingInterval = 30 * time.Second
+	// readTimeout closes a connection that sent nothing, not even a pong,
+	// for this long.
+	readTimeout = 2 * pingInterval
+	// writeTimeout bounds a single frame write.
+	writeTimeout = 10 * time.Second
+)
+
+// Handler serves live mixing connections. It is safe for concurrent use.
+type Handler struct {
+	src             mixing.Source
+	maxMessageBytes int64
+	// busy holds a token for each computation in progress.
+	busy chan struct{}
+	// closing is closed by Shutdown, which then waits for conns.
+	mu      sync.Mutex
+	closing chan struct{}
+	closed  bool
+	conns   sync.WaitGroup
+}
+
+// Option configures a Handler.
+type Option func(*Handler) error
+
+// WithMixer mixes with m instead of the default Mixer.
+func WithMixer(m *mixbox.Mixer) Option {
+	return func(h *Handler) error {
+		if err := h.src.SetMixer(m); err != nil {
+			return fmt.Errorf("live: %w", err)
+		}
+		return nil
+	}
+}
+
+// WithPalette resolves pigment names and codes in p instead of the default
+// palette; see pigments.Default.
+func WithPalette(p *pigments.Palette) Option {
+	return func(h *Handler) error {
+		if err := h.src.SetPalette(p); err != nil {
+			return fmt.Errorf("live: %w", err)
+		}
+		return nil
+	}
+}
+
+// WithMaxMessageBytes limits client messages to n bytes; a larger message
+// closes the connection.
+func WithMaxMessageBytes(n int64) Option {
+	return func(h *Handler) error {
+		if n < 1 {
+			return fmt.Errorf("live: invalid message limit %d", n)
+		}
+		h.maxMessageBytes = n
+		return nil
+	}
+}
+
+// WithConcurrency allows n computations at once across all connections,
+// instead of one per CPU.
+func WithConcurrency(n int) Option {
+	return func(h *Handler) error {
+		if n < 1 {
+			return fmt.Errorf("live: invalid concurrency %d", n)
+		}
+		h.busy = make(chan struct{}, n)
+		return nil
+	}
+}
+
+// New returns a Handler that upgrades each request to a WebSocket.
+func New(opts ...Option) (*Handler, error) {
+	h := &Handler{
+		maxMessageBytes: DefaultMaxMessageBytes,
+		busy:            make(chan struct{}, runtime.GOMAXPROCS(0)),
+		closing:         make(chan struct{}),
+	}
+	for _, opt := range opts {
+		if err := opt(h); err != nil {
+			return nil, err
+		}
+	}
+	return h, nil
+}
+
+// Shutdown closes every connection with status 1001 (going away), refuses
+// new ones, and returns once all are closed. http.Server.Shutdown does not
+// track WebSocket connections, so call it alongside.
+func (h *Handler) Shutdown() {
+	h.mu.Lock()
+	if !h.closed {
+		h.closed = true
+		close(h.closing)
+	}
+	h.mu.Unlock()
+	h.conns.Wait()
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mu.Lock()
+	if h.closed {
+		h.mu.Unlock()
+		http.Error(w, "server is shutting down", http.StatusServiceUnavailable)
+		return
+	}
+	h.conns.Add(1)
+	h.mu.Unlock()
+	defer h.conns.Done()
+
+	conn, err := upgrade(w, r, h.maxMessageBytes)
+	if err != nil {
+		return
+	}
+	s := &session{wake: make(chan struct{}, 1)}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			msg, err := conn.readMessage()
+			if err != nil {
+				return
+			}
+			s.put(msg)
+		}
+	}()
+	h.answer(conn, s, done)
+	conn.close(closeGoingAway, "")
+	<-done
+}
+
+// answer computes and sends the newest state of s whenever one arrives,
+// until done is closed or a write fails.
+func (h *Handler) answer(conn *wsConn, s *session, done <-chan struct{}) {
+	ping := time.NewTicker(pingInterval)
+	defer ping.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-h.closing:
+			conn.close(closeGoingAway, "server is shutting down")
+			return
+		case <-ping.C:
+			if conn.writeFrame(opPing, nil) != nil {
+				return
+			}
+			continue
+		case <-s.wake:
+		}
+
+		// Wait for a turn before taking the state, so that states arriving
+		// in the meantime replace it.
+		select {
+		case h.busy <- struct{}{}:
+		case <-done:
+			return
+		}
+		msg, skipped := s.take()
+		if msg == nil {
+			// Already answered by the previous wake-up.
+			<-h.busy
+			continue
+		}
+		resp := h.compute(s, msg)
+		<-h.busy
+
+		resp.Coalesced = skipped
+		b, err := json.Marshal(resp)
+		if err != nil {
+			return
+		}
+		if conn.writeText(b) != nil {
+			return
+		}
+	}
+}
+
+// session is the state of one connection.
+type session struct {
+	mu       sync.Mutex
+	latest   []byte // newest message not yet computed
+	received int    // messages received since the last take
+	wake     chan struct{}
+
+	// The gradient of the previous state, reused while the Mixer, the
+	// colors and the number of stops stay the same. Only the answering
+	// goroutine uses these.
+	mixer  *mixbox.Mixer
+	colors [][3]uint8
+	grad   *gradient.Gradient
+	stops  []stop
+	css    string
+}
+
+// put stores msg as the newest state and wakes the answering goroutine.
+func (s *session) put(msg []byte) {
+	s.mu.Lock()
+	s.latest = msg
+	s.received++
+	s.mu.Unlock()
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// take returns the newest state and how many older ones it replaced.
+func (s *session) take() (msg []byte, skipped int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	msg, skipped = s.latest, max(s.received-1, 0)
+	s.latest, s.received = nil, 0
+	return msg, skipped
+}
+
+// state is a client message.
+type state struct {
+	Seq    int64    `json:"seq"`
+	Colors []string `json:"colors"`
+	// Ratio is the position along the gradient, 0.5 if omitted.
+	Ratio *float64 `json:"ratio"`
+	// Stops is the number of evenly spaced stops returned, 11 if omitted.
+	Stops int `json:"stops"`
+}
+
+type stop struct {
+	Position float64 `json:"position"`
+	Color    string  `json:"color"`
+}
+
+// response is a server message.
+type response struct {
+	Seq         int64  `json:"seq"`
+	Error       string `json:"error,omitempty"`
+	Color       string `json:"color,omitempty"`
+	LinearColor string `json:"linearColor,omitempty"`
+	Stops       []stop `json:"stops,omitempty"`
+	CSS         string `json:"css,omitempty"`
+	Coalesced   int    `json:"coalesced"`
+}
+
+func (h *Handler) compute(s *session, msg []byte) response {
+	st := state{Stops: mixing.DefaultStops}
+	if err := json.Unmarshal(msg, &st); err != nil {
+		return response{Seq: st.Seq, Error: "invalid message: " + err.Error()}
+	}
+	fail := func(format string, args ...any) response {
+		return response{Seq: st.Seq, Error: fmt.Sprintf(format, args...)}
+	}
+	if len(st.Colors) < 2 || len(st.Colors) > mixing.MaxColors {
+		return fail("need 2 to %d colors, got %d", mixing.MaxColors, len(st.Colors))
+	}
+	if st.Stops < 2 || st.Stops > mixing.MaxStops {
+		return fail("stops must be between 2 and %d, got %d", mixing.MaxStops, st.Stops)
+	}
+	ratio := 0.5
+	if st.Ratio != nil {
+		ratio = *st.Ratio
+		if !(ratio >= 0 && ratio <= 1) {
+			return fail("ratio must be between 0 and 1, got %v", ratio)
+		}
+	}
+	palette := h.src.Palette()
+	colors := make([][3]uint8, len(st.Colors))
+	for i, c := range st.Colors {
+		p, err := palette.Resolve(c)
+		if err != nil {
+			return fail("colors[%d] is not a color or pigment: %v", i, err)
+		}
+		colors[i] = p.RGB
+	}
+
+	m := h.src.Mixer()
+	if s.grad == nil || m != s.mixer || !slices.Equal(colors, s.colors) {
+		g, err := gradient.Even(colors, gradient.WithMixer(m))
+		if err != nil {
+			return fail("%v", err)
+		}
+		s.mixer, s.colors, s.grad, s.stops, s.css = m, colors, g, nil, g.CSS("to right")
+	}
+	if len(s.stops) != st.Stops {
+		s.stops = s.stops[:0]
+		for i, rgb := range s.grad.Sample(st.Stops) {
+			s.stops = append(s.stops, stop{
+				Position: float64(i) / float64(st.Stops-1),
+				Color:    colorparse.FromRGB(rgb).Hex(),
+			})
+		}
+	}
+
+	return response{
+		Seq:         st.Seq,
+		Color:       colorparse.FromRGB(s.grad.At(ratio)).Hex(),
+		LinearColor: colorparse.FromRGB(linearAt(colors, ratio)).Hex(),
+		Stops:       s.stops,
+		CSS:         s.css,
+	}
+}
+
+// linearAt interpolates RGB at position t of an even gradient through
+// colors.
+func linearAt(colors [][3]uint8, t float64) [3]uint8 {
+	x := t * float64(len(colors)-1)
+	i := min(int(x), len(colors)-2)
+	u := x - float64(i)
+	var rgb [3]uint8
+	for ch := range rgb {
+		a, b := float64(colors[i][ch]), float64(colors[i+1][ch])
+		rgb[ch] = uint8(a + (b-a)*u + 0.5)
+	}
+	return rgb
+}
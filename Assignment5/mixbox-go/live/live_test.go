@@ -0,0 +1,194 @@
+package live
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/timf34/mixbox-go/colorparse"
+	"github.com/timf34/mixbox-go/gradient"
+	"github.com/timf34/mixbox-go/mixbox"
+	"github.com/timf34/mixbox-go/pigments"
+)
+
+// testHandler returns a Handler on the embedded table, skipping the test
+// when the mixbox package was built without one.
+func testHandler(t *testing.T, opts ...Option) *Handler {
+	t.Helper()
+	if _, ok := mixbox.DefaultOK(); !ok {
+		t.Skip("built without an embedded LUT")
+	}
+	h, err := New(opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+func TestNewErrors(t *testing.T) {
+	for name, opt := range map[string]Option{
+		"nil Mixer":      WithMixer(nil),
+		"nil Palette":    WithPalette(nil),
+		"message limit":  WithMaxMessageBytes(0),
+		"no concurrency": WithConcurrency(0),
+	} {
+		if _, err := New(opt); err == nil || !strings.HasPrefix(err.Error(), "live: ") {
+			t.Errorf("%s: err = %v", name, err)
+		}
+	}
+}
+
+func TestState(t *testing.T) {
+	srv := httptest.NewServer(testHandler(t))
+	defer srv.Close()
+	c := dial(t, srv)
+
+	c.send(map[string]any{"seq": 7, "colors": []string{"#feec00", "ultramarine blue"}, "ratio": 0.25, "stops": 3})
+	resp := c.receive()
+	yellow, blue := [3]uint8{254, 236, 0}, pigments.UltramarineBlue.RGB
+	g, err := gradient.Even([][3]uint8{yellow, blue})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Seq != 7 || resp.Error != "" || resp.Coalesced != 0 {
+		t.Fatalf("response %+v", resp)
+	}
+	if want := colorparse.FromRGB(mixbox.Default().Lerp(yellow, blue, 0.25)).Hex(); resp.Color != want {
+		t.Errorf("color %s, want %s", resp.Color, want)
+	}
+	if want := colorparse.FromRGB(linearAt([][3]uint8{yellow, blue}, 0.25)).Hex(); resp.LinearColor != want {
+		t.Errorf("linearColor %s, want %s", resp.LinearColor, want)
+	}
+	if len(resp.Stops) != 3 || resp.Stops[0].Color != "#feec00" || resp.Stops[2].Color != "#190059" || resp.Stops[1].Position != 0.5 {
+		t.Errorf("stops %+v", resp.Stops)
+	}
+	if want := g.CSS("to right"); resp.CSS != want {
+		t.Errorf("css %s, want %s", resp.CSS, want)
+	}
+
+	// Defaults: ratio 0.5 and 11 stops.
+	c.send(map[string]any{"seq": 8, "colors": []string{"#feec00", "PB29"}})
+	resp = c.receive()
+	if want := colorparse.FromRGB(g.At(0.5)).Hex(); resp.Seq != 8 || resp.Color != want || len(resp.Stops) != 11 {
+		t.Errorf("response with defaults %+v, want color %s and 11 stops", resp, want)
+	}
+}
+
+func TestInvalidState(t *testing.T) {
+	srv := httptest.NewServer(testHandler(t))
+	defer srv.Close()
+	c := dial(t, srv)
+	for _, tt := range []struct {
+		msg  string
+		want string
+	}{
+		{`{"seq": 1, "colors": ["red"]}`, "need 2 to 16 colors, got 1"},
+		{`{"seq": 2, "colors": ["red", "blue"], "stops": 1}`, "stops must be between 2 and 256"},
+		{`{"seq": 3, "colors": ["red", "blue"], "ratio": 1.5}`, "ratio must be between 0 and 1"},
+		{`{"seq": 4, "colors": ["red", "nope"]}`, "colors[1] is not a color or pigment"},
+		{`{"seq": 5, "colors": "red"}`, "invalid message"},
+		{`not json`, "invalid message"},
+	} {
+		c.writeFrame(true, opText, []byte(tt.msg))
+		if resp := c.receive(); !strings.Contains(resp.Error, tt.want) || resp.Color != "" {
+			t.Errorf("%s: response %+v, want error %q", tt.msg, resp, tt.want)
+		}
+	}
+	// The connection stays open.
+	c.send(map[string]any{"seq": 9, "colors": []string{"red", "blue"}})
+	if resp := c.receive(); resp.Seq != 9 || resp.Error != "" || resp.Color == "" {
+		t.Errorf("valid state after errors: %+v", resp)
+	}
+}
+
+func TestCoalescing(t *testing.T) {
+	h := testHandler(t, WithConcurrency(1))
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+	c := dial(t, srv)
+
+	// Hold the only computation slot while the states arrive.
+	h.busy <- struct{}{}
+	for seq := 1; seq <= 5; seq++ {
+		c.send(map[string]any{"seq": seq, "colors": []string{"red", "blue"}, "ratio": float64(seq) / 10})
+	}
+	// Pings are answered in order with the messages, so the pong means
+	// all five states were received.
+	c.writeFrame(true, opPing, nil)
+	if op, _ := c.readFrame(); op != opPong {
+		t.Fatalf("got frame %#x before the pong", op)
+	}
+	<-h.busy
+
+	resp := c.receive()
+	if resp.Seq != 5 || resp.Coalesced != 4 {
+		t.Errorf("response seq %d, coalesced %d; want 5 and 4", resp.Seq, resp.Coalesced)
+	}
+	c.send(map[string]any{"seq": 6, "colors": []string{"red", "blue"}})
+	if resp := c.receive(); resp.Seq != 6 || resp.Coalesced != 0 {
+		t.Errorf("next response seq %d, coalesced %d; want 6 and 0", resp.Seq, resp.Coalesced)
+	}
+}
+
+func TestMessageTooBig(t *testing.T) {
+	srv := httptest.NewServer(testHandler(t, WithMaxMessageBytes(64)))
+	defer srv.Close()
+
+	// A message at the limit is accepted.
+	c := dial(t, srv)
+	msg := `{"seq": 1, "colors": ["red", "blue"]}`
+	msg += strings.Repeat(" ", 64-len(msg))
+	c.writeFrame(true, opText, []byte(msg))
+	if resp := c.receive(); resp.Seq != 1 || resp.Error != "" {
+		t.Errorf("message at the limit: %+v", resp)
+	}
+
+	for name, write := range map[string]func(c *client){
+		"one frame": func(c *client) { c.writeFrame(true, opText, []byte(strings.Repeat(" ", 65))) },
+		"fragments": func(c *client) {
+			c.writeFrame(false, opText, []byte(strings.Repeat(" ", 40)))
+			c.writeFrame(true, opContinuation, []byte(strings.Repeat(" ", 40)))
+		},
+	} {
+		c := dial(t, srv)
+		write(c)
+		if code, reason := c.expectClose(); code != closeTooBig || !strings.Contains(reason, "64 bytes") {
+			t.Errorf("%s: closed with %d %q, want %d", name, code, reason, closeTooBig)
+		}
+	}
+}
+
+func TestShutdown(t *testing.T) {
+	h := testHandler(t)
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+	c := dial(t, srv)
+	c.send(map[string]any{"seq": 1, "colors": []string{"red", "blue"}})
+	c.receive()
+
+	done := make(chan struct{})
+	go func() {
+		h.Shutdown()
+		close(done)
+	}()
+	if code, _ := c.expectClose(); code != closeGoingAway {
+		t.Errorf("closed with %d, want %d", code, closeGoingAway)
+	}
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("Shutdown did not return after the connection closed")
+	}
+
+	resp, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("request after Shutdown: status %d, want 503", resp.StatusCode)
+	}
+	h.Shutdown() // a second call returns at once
+}
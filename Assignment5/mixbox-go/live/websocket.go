@@ -0,0 +1,304 @@
+package live
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// This file implements the server side of the WebSocket protocol (RFC
+// 6455), as much of it as a JSON message channel needs: text messages,
+// fragmentation, ping, pong and close. Extensions and subprotocols are not
+// negotiated.
+
+const (
+	opContinuation = 0x0
+	opText         = 0x1
+	opBinary       = 0x2
+	opClose        = 0x8
+	opPing         = 0x9
+	opPong         = 0xa
+)
+
+// Close status codes.
+const (
+	closeNormal         = 1000
+	closeGoingAway      = 1001
+	closeProtocolError  = 1002
+	closeUnsupported    = 1003
+	closeInvalidPayload = 1007
+	closeTooBig         = 1009
+)
+
+// websocketGUID is the fixed key suffix of the opening handshake.
+const websocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// closeError is returned by readMessage when the connection closes, either
+// because the client sent a close frame or because it broke the protocol.
+type closeError struct {
+	code   int
+	reason string
+}
+
+func (e *closeError) Error() string {
+	return fmt.Sprintf("live: websocket closed (%d %s)", e.code, e.reason)
+}
+
+// wsConn is a server-side WebSocket connection. readMessage must be called
+// from one goroutine only; writes may come from any goroutine.
+type wsConn struct {
+	conn       net.Conn
+	r          *bufio.Reader
+	maxMessage int64
+
+	wmu    sync.Mutex
+	closed bool
+}
+
+// upgrade performs the opening handshake. On failure it has already
+// written an HTTP error response.
+func upgrade(w http.ResponseWriter, r *http.Request, maxMessage int64) (*wsConn, error) {
+	fail := func(status int, msg string) (*wsConn, error) {
+		http.Error(w, msg, status)
+		return nil, errors.New("live: " + msg)
+	}
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		return fail(http.StatusMethodNotAllowed, "websocket handshake must be a GET")
+	}
+	if !headerHasToken(r.Header, "Connection", "upgrade") || !headerHasToken(r.Header, "Upgrade", "websocket") {
+		w.Header().Set("Upgrade", "websocket")
+		return fail(http.StatusUpgradeRequired, "this endpoint only speaks WebSocket")
+	}
+	if r.Header.Get("Sec-WebSocket-Version") != "13" {
+		w.Header().Set("Sec-WebSocket-Version", "13")
+		return fail(http.StatusBadRequest, "unsupported WebSocket version")
+	}
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if decoded, err := base64.StdEncoding.DecodeString(key); err != nil || len(decoded) != 16 {
+		return fail(http.StatusBadRequest, "invalid Sec-WebSocket-Key")
+	}
+	if !sameOrigin(r) {
+		return fail(http.StatusForbidden, "cross-origin WebSocket requests are not allowed")
+	}
+
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		return fail(http.StatusInternalServerError, "connection cannot be hijacked")
+	}
+	conn, brw, err := hj.Hijack()
+	if err != nil {
+		return nil, err
+	}
+	sum := sha1.Sum([]byte(key + websocketGUID))
+	accept := base64.StdEncoding.EncodeToString(sum[:])
+	// The handshake request has no body, so nothing the client sends
+	// before our response is lost by writing straight to conn.
+	if _, err := io.WriteString(conn, "HTTP/1.1 101 Switching Protocols\r\n"+
+		"Upgrade: websocket\r\nConnection: Upgrade\r\n"+
+		"Sec-WebSocket-Accept: "+accept+"\r\n\r\n"); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &wsConn{conn: conn, r: brw.Reader, maxMessage: maxMessage}, nil
+}
+
+// headerHasToken reports whether a comma-separated header contains token,
+// ignoring case.
+func headerHasToken(h http.Header, name, token string) bool {
+	for _, v := range h.Values(name) {
+		for _, t := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(t), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// sameOrigin reports whether the request comes from a page served by this
+// host. Browsers always send Origin with WebSocket handshakes; clients that
+// are not browsers may omit it.
+func sameOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	return err == nil && strings.EqualFold(u.Host, r.Host)
+}
+
+// readMessage returns the next text message, answering pings and
+// reassembling fragments on the way. It returns a *closeError when the
+// connection closes.
+func (c *wsConn) readMessage() ([]byte, error) {
+	var msg []byte
+	var msgOp byte
+	for {
+		fin, op, payload, err := c.readFrame()
+		if err != nil {
+			return nil, err
+		}
+		switch op {
+		case opPing:
+			if err := c.writeFrame(opPong, payload); err != nil {
+				return nil, err
+			}
+			continue
+		case opPong:
+			continue
+		case opClose:
+			code := closeNormal
+			if len(payload) >= 2 {
+				code = int(binary.BigEndian.Uint16(payload))
+			}
+			c.close(code, "")
+			return nil, &closeError{code, string(payload[min(2, len(payload)):])}
+		case opContinuation:
+			if msgOp == 0 {
+				return nil, c.fail(closeProtocolError, "continuation without a message")
+			}
+		case opText, opBinary:
+			if msgOp != 0 {
+				return nil, c.fail(closeProtocolError, "new message inside a fragmented one")
+			}
+			msgOp = op
+		default:
+			return nil, c.fail(closeProtocolError, fmt.Sprintf("unknown opcode %#x", op))
+		}
+
+		if int64(len(msg)+len(payload)) > c.maxMessage {
+			return nil, c.fail(closeTooBig, fmt.Sprintf("message exceeds %d bytes", c.maxMessage))
+		}
+		msg = append(msg, payload...)
+		if !fin {
+			continue
+		}
+		if msgOp == opBinary {
+			return nil, c.fail(closeUnsupported, "binary messages are not supported")
+		}
+		if !utf8.Valid(msg) {
+			return nil, c.fail(closeInvalidPayload, "text message is not UTF-8")
+		}
+		return msg, nil
+	}
+}
+
+// readFrame reads and unmasks one frame.
+func (c *wsConn) readFrame() (fin bool, op byte, payload []byte, err error) {
+	c.conn.SetReadDeadline(time.Now().Add(readTimeout))
+	var head [2]byte
+	if _, err = io.ReadFull(c.r, head[:]); err != nil {
+		return
+	}
+	fin = head[0]&0x80 != 0
+	op = head[0] & 0x0f
+	if head[0]&0x70 != 0 {
+		err = c.fail(closeProtocolError, "reserved bits set")
+		return
+	}
+	if head[1]&0x80 == 0 {
+		err = c.fail(closeProtocolError, "client frames must be masked")
+		return
+	}
+	n := int64(head[1] & 0x7f)
+	switch n {
+	case 126:
+		var ext [2]byte
+		if _, err = io.ReadFull(c.r, ext[:]); err != nil {
+			return
+		}
+		n = int64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err = io.ReadFull(c.r, ext[:]); err != nil {
+			return
+		}
+		n = int64(binary.BigEndian.Uint64(ext[:]) & (1<<63 - 1))
+	}
+	if op >= opClose && (n > 125 || !fin) {
+		err = c.fail(closeProtocolError, "invalid control frame")
+		return
+	}
+	if n > c.maxMessage {
+		err = c.fail(closeTooBig, fmt.Sprintf("message exceeds %d bytes", c.maxMessage))
+		return
+	}
+	var mask [4]byte
+	if _, err = io.ReadFull(c.r, mask[:]); err != nil {
+		return
+	}
+	payload = make([]byte, n)
+	if _, err = io.ReadFull(c.r, payload); err != nil {
+		return
+	}
+	for i := range payload {
+		payload[i] ^= mask[i&3]
+	}
+	return
+}
+
+// writeText sends a text message in a single frame.
+func (c *wsConn) writeText(msg []byte) error {
+	return c.writeFrame(opText, msg)
+}
+
+func (c *wsConn) writeFrame(op byte, payload []byte) error {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	if c.closed {
+		return net.ErrClosed
+	}
+	return c.writeFrameLocked(op, payload)
+}
+
+func (c *wsConn) writeFrameLocked(op byte, payload []byte) error {
+	frame := make([]byte, 0, 10+len(payload))
+	frame = append(frame, 0x80|op)
+	switch n := len(payload); {
+	case n <= 125:
+		frame = append(frame, byte(n))
+	case n <= 0xffff:
+		frame = append(frame, 126)
+		frame = binary.BigEndian.AppendUint16(frame, uint16(n))
+	default:
+		frame = append(frame, 127)
+		frame = binary.BigEndian.AppendUint64(frame, uint64(n))
+	}
+	frame = append(frame, payload...)
+	c.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	_, err := c.conn.Write(frame)
+	return err
+}
+
+// fail closes the connection with a protocol error and returns the
+// matching closeError.
+func (c *wsConn) fail(code int, reason string) error {
+	c.close(code, reason)
+	return &closeError{code, reason}
+}
+
+// close sends a close frame, if none was sent yet, and closes the
+// connection.
+func (c *wsConn) close(code int, reason string) {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	if c.closed {
+		return
+	}
+	c.closed = true
+	payload := binary.BigEndian.AppendUint16(nil, uint16(code))
+	c.writeFrameLocked(opClose, append(payload, reason...))
+	c.conn.Close()
+}
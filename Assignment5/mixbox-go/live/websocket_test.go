@@ -0,0 +1,229 @@
+package live
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// client is the client side of a WebSocket connection, just enough of it
+// to drive a Handler.
+type client struct {
+	t    *testing.T
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+// dial opens a WebSocket connection to srv.
+func dial(t *testing.T, srv *httptest.Server) *client {
+	t.Helper()
+	conn, err := net.Dial("tcp", srv.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	conn.SetDeadline(time.Now().Add(10 * time.Second))
+
+	key := make([]byte, 16)
+	rand.Read(key)
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	req.Header.Set("Sec-WebSocket-Key", base64.StdEncoding.EncodeToString(key))
+	if err := req.Write(conn); err != nil {
+		t.Fatal(err)
+	}
+	r := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(r, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		body, _ := io.ReadAll(resp.Body)
+		t.Fatalf("handshake: %s: %s", resp.Status, body)
+	}
+	if !headerHasToken(resp.Header, "Upgrade", "websocket") || resp.Header.Get("Sec-WebSocket-Accept") == "" {
+		t.Fatalf("handshake response headers %v", resp.Header)
+	}
+	return &client{t, conn, r}
+}
+
+// writeFrame sends a masked frame.
+func (c *client) writeFrame(fin bool, op byte, payload []byte) {
+	c.t.Helper()
+	head := op
+	if fin {
+		head |= 0x80
+	}
+	frame := []byte{head}
+	switch n := len(payload); {
+	case n <= 125:
+		frame = append(frame, 0x80|byte(n))
+	case n <= 0xffff:
+		frame = append(frame, 0x80|126)
+		frame = binary.BigEndian.AppendUint16(frame, uint16(n))
+	default:
+		frame = append(frame, 0x80|127)
+		frame = binary.BigEndian.AppendUint64(frame, uint64(n))
+	}
+	mask := [4]byte{1, 2, 3, 4}
+	frame = append(frame, mask[:]...)
+	for i, b := range payload {
+		frame = append(frame, b^mask[i&3])
+	}
+	if _, err := c.conn.Write(frame); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+// send sends v as a JSON text message.
+func (c *client) send(v any) {
+	c.t.Helper()
+	b, err := json.Marshal(v)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	c.writeFrame(true, opText, b)
+}
+
+// readFrame reads one unmasked server frame.
+func (c *client) readFrame() (op byte, payload []byte) {
+	c.t.Helper()
+	var head [2]byte
+	if _, err := io.ReadFull(c.r, head[:]); err != nil {
+		c.t.Fatal(err)
+	}
+	if head[0]&0x80 == 0 || head[1]&0x80 != 0 {
+		c.t.Fatalf("server frame header %#x %#x: want FIN set and no mask", head[0], head[1])
+	}
+	n := int(head[1] & 0x7f)
+	switch n {
+	case 126:
+		var ext [2]byte
+		io.ReadFull(c.r, ext[:])
+		n = int(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		io.ReadFull(c.r, ext[:])
+		n = int(binary.BigEndian.Uint64(ext[:]))
+	}
+	payload = make([]byte, n)
+	if _, err := io.ReadFull(c.r, payload); err != nil {
+		c.t.Fatal(err)
+	}
+	return head[0] & 0x0f, payload
+}
+
+// receive reads the next text message into a response.
+func (c *client) receive() response {
+	c.t.Helper()
+	op, payload := c.readFrame()
+	if op != opText {
+		c.t.Fatalf("got frame %#x %q, want a text message", op, payload)
+	}
+	var resp response
+	if err := json.Unmarshal(payload, &resp); err != nil {
+		c.t.Fatalf("invalid response %s: %v", payload, err)
+	}
+	return resp
+}
+
+// expectClose reads frames until a close frame and returns its status.
+func (c *client) expectClose() (code int, reason string) {
+	c.t.Helper()
+	for {
+		op, payload := c.readFrame()
+		if op != opClose {
+			continue
+		}
+		if len(payload) < 2 {
+			c.t.Fatalf("close frame %q without a status", payload)
+		}
+		return int(binary.BigEndian.Uint16(payload)), string(payload[2:])
+	}
+}
+
+func TestUpgradeErrors(t *testing.T) {
+	h := testHandler(t)
+	good := func() *http.Request {
+		r := httptest.NewRequest(http.MethodGet, "http://example.com/live", nil)
+		r.Header.Set("Connection", "keep-alive, Upgrade")
+		r.Header.Set("Upgrade", "websocket")
+		r.Header.Set("Sec-WebSocket-Version", "13")
+		r.Header.Set("Sec-WebSocket-Key", "dGhlIHNhbXBsZSBub25jZQ==")
+		return r
+	}
+	for _, tt := range []struct {
+		name   string
+		change func(r *http.Request)
+		status int
+	}{
+		{"post", func(r *http.Request) { r.Method = http.MethodPost }, http.StatusMethodNotAllowed},
+		{"plain get", func(r *http.Request) { r.Header.Del("Upgrade") }, http.StatusUpgradeRequired},
+		{"old version", func(r *http.Request) { r.Header.Set("Sec-WebSocket-Version", "8") }, http.StatusBadRequest},
+		{"bad key", func(r *http.Request) { r.Header.Set("Sec-WebSocket-Key", "short") }, http.StatusBadRequest},
+		{"cross origin", func(r *http.Request) { r.Header.Set("Origin", "https://evil.example") }, http.StatusForbidden},
+		// httptest.ResponseRecorder cannot be hijacked, so a request that
+		// passes every check fails there.
+		{"same origin", func(r *http.Request) { r.Header.Set("Origin", "http://example.com") }, http.StatusInternalServerError},
+	} {
+		r := good()
+		tt.change(r)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		if w.Code != tt.status {
+			t.Errorf("%s: status %d, want %d: %s", tt.name, w.Code, tt.status, strings.TrimSpace(w.Body.String()))
+		}
+	}
+}
+
+func TestProtocolErrors(t *testing.T) {
+	h := testHandler(t)
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+	for _, tt := range []struct {
+		name  string
+		write func(c *client)
+		code  int
+	}{
+		{"binary", func(c *client) { c.writeFrame(true, opBinary, []byte{1}) }, closeUnsupported},
+		{"invalid UTF-8", func(c *client) { c.writeFrame(true, opText, []byte{0xff}) }, closeInvalidPayload},
+		{"bare continuation", func(c *client) { c.writeFrame(true, opContinuation, []byte("x")) }, closeProtocolError},
+		{"unknown opcode", func(c *client) { c.writeFrame(true, 0x3, nil) }, closeProtocolError},
+		{"fragmented ping", func(c *client) { c.writeFrame(false, opPing, nil) }, closeProtocolError},
+		{"unmasked", func(c *client) { c.conn.Write([]byte{0x81, 0x00}) }, closeProtocolError},
+	} {
+		c := dial(t, srv)
+		tt.write(c)
+		if code, _ := c.expectClose(); code != tt.code {
+			t.Errorf("%s: closed with %d, want %d", tt.name, code, tt.code)
+		}
+	}
+}
+
+func TestPingPong(t *testing.T) {
+	srv := httptest.NewServer(testHandler(t))
+	defer srv.Close()
+	c := dial(t, srv)
+	c.writeFrame(true, opPing, []byte("hello"))
+	if op, payload := c.readFrame(); op != opPong || string(payload) != "hello" {
+		t.Errorf("answer to ping: %#x %q", op, payload)
+	}
+	c.writeFrame(true, opClose, binary.BigEndian.AppendUint16(nil, closeNormal))
+	if code, _ := c.expectClose(); code != closeNormal {
+		t.Errorf("close answered with %d", code)
+	}
+}
@@ -0,0 +1,78 @@
+package mixbox
+
+import "image/color"
+
+// Mixing semi-transparent colors treats alpha as the amount of paint: the
+// coverage is interpolated linearly, and each color contributes to the mix
+// in proportion to its share of that coverage. A fully transparent endpoint
+// therefore adds no color at all; mixing red with transparent black gives a
+// fainter red, not a darker one.
+
+// alphaShare returns the coverage of mixing coverages a1 and a2 at ratio t,
+// and the second color's share of the mixed color. If both are transparent
+// the share is t.
+func alphaShare(a1, a2, t float64) (a, share float64) {
+	w1, w2 := (1-t)*a1, t*a2
+	a = w1 + w2
+	if a == 0 {
+		return 0, t
+	}
+	return a, w2 / a
+}
+
+// LerpNRGBA calls Mixer.LerpNRGBA on the default Mixer.
+func LerpNRGBA(c1, c2 color.NRGBA, t float64) color.NRGBA {
+	return Default().LerpNRGBA(c1, c2, t)
+}
+
+// LerpRGBA calls Mixer.LerpRGBA on the default Mixer.
+func LerpRGBA(c1, c2 color.RGBA, t float64) color.RGBA {
+	return Default().LerpRGBA(c1, c2, t)
+}
+
+// LerpNRGBA mixes two straight-alpha colors. t is clamped to 0..1. The
+// result's alpha is the linear interpolation of the alphas; its color is
+// the latent mix weighted by coverage, so a transparent endpoint leaves the
+// other color unchanged. Mixing two transparent colors gives transparent
+// black.
+func (m *Mixer) LerpNRGBA(c1, c2 color.NRGBA, t float64) color.NRGBA {
+	t = clamp01(t)
+	a, share := alphaShare(float64(c1.A)/0xff, float64(c2.A)/0xff, t)
+	if a == 0 {
+		return color.NRGBA{}
+	}
+	rgb1 := [3]uint8{c1.R, c1.G, c1.B}
+	rgb2 := [3]uint8{c2.R, c2.G, c2.B}
+	var rgb [3]uint8
+	switch share {
+	case 0:
+		rgb = rgb1
+	case 1:
+		rgb = rgb2
+	default:
+		rgb = m.Lerp(rgb1, rgb2, share)
+	}
+	return color.NRGBA{R: rgb[0], G: rgb[1], B: rgb[2], A: uint8(a*0xff + 0.5)}
+}
+
+// LerpRGBA is LerpNRGBA for premultiplied colors: the inputs are
+// unpremultiplied before mixing and the result is premultiplied again.
+func (m *Mixer) LerpRGBA(c1, c2 color.RGBA, t float64) color.RGBA {
+	rgb1, a1 := unpremultiply8(c1.R, c1.G, c1.B, c1.A)
+	rgb2, a2 := unpremultiply8(c2.R, c2.G, c2.B, c2.A)
+	n := m.LerpNRGBA(
+		color.NRGBA{R: rgb1[0], G: rgb1[1], B: rgb1[2], A: a1},
+		color.NRGBA{R: rgb2[0], G: rgb2[1], B: rgb2[2], A: a2},
+		t,
+	)
+	return color.RGBA{
+		R: premultiply8(n.R, n.A),
+		G: premultiply8(n.G, n.A),
+		B: premultiply8(n.B, n.A),
+		A: n.A,
+	}
+}
+
+func premultiply8(c, a uint8) uint8 {
+	return uint8((uint32(c)*uint32(a) + 127) / 255)
+}
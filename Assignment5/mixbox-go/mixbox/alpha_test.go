@@ -0,0 +1,101 @@
+package mixbox
+
+import (
+	"image/color"
+	"testing"
+)
+
+// Unpremultiplying and premultiplying again gives back the premultiplied
+// value, so repeated round trips neither darken nor drift.
+func TestPremultiplyRoundTrip(t *testing.T) {
+	for a := 1; a < 256; a++ {
+		for c := 0; c <= a; c++ {
+			rgb, _ := unpremultiply8(uint8(c), 0, 0, uint8(a))
+			if got := premultiply8(rgb[0], uint8(a)); got != uint8(c) {
+				t.Fatalf("premultiplied %d at alpha %d comes back as %d", c, a, got)
+			}
+		}
+		for c := 0; c < 256; c++ {
+			p := premultiply8(uint8(c), uint8(a))
+			straight := uint8(c)
+			for range 10 {
+				rgb, _ := unpremultiply8(p, p, p, uint8(a))
+				straight = rgb[0]
+				p = premultiply8(straight, uint8(a))
+			}
+			if p != premultiply8(uint8(c), uint8(a)) {
+				t.Fatalf("straight %d at alpha %d drifts to %d after round trips", c, a, straight)
+			}
+		}
+	}
+	if rgb, a := unpremultiply8(200, 10, 10, 100); rgb[0] != 255 || a != 100 {
+		t.Errorf("a channel above alpha unpremultiplies to %d, want 255", rgb[0])
+	}
+}
+
+func TestLerpNRGBA(t *testing.T) {
+	m := testMixer(t)
+	red, blue := color.NRGBA{255, 39, 2, 255}, color.NRGBA{25, 0, 89, 255}
+	transparent := color.NRGBA{}
+	for _, tt := range []struct {
+		name   string
+		c1, c2 color.NRGBA
+		t      float64
+		want   color.NRGBA
+	}{
+		{"opaque", red, blue, 0.3, withAlpha(m.Lerp(rgbOf(red), rgbOf(blue), 0.3), 255)},
+		{"fading out", red, transparent, 0.25, withAlpha(rgbOf(red), 191)},
+		{"fading in", transparent, blue, 0.25, withAlpha(rgbOf(blue), 64)},
+		{"both transparent", color.NRGBA{255, 0, 0, 0}, color.NRGBA{0, 0, 255, 0}, 0.5, transparent},
+		// Coverages 1 and 1/3 at t = 0.5: blue holds a quarter of the paint.
+		{"partly covered", red, color.NRGBA{25, 0, 89, 85}, 0.5, withAlpha(m.Lerp(rgbOf(red), rgbOf(blue), 0.25), 170)},
+		{"alpha only", color.NRGBA{25, 0, 89, 200}, color.NRGBA{25, 0, 89, 100}, 0.25, withAlpha(rgbOf(blue), 175)},
+		{"t below 0", red, blue, -1, red},
+		{"t above 1", red, blue, 2, blue},
+	} {
+		if got := m.LerpNRGBA(tt.c1, tt.c2, tt.t); got != tt.want {
+			t.Errorf("%s: LerpNRGBA(%v, %v, %v) = %v, want %v", tt.name, tt.c1, tt.c2, tt.t, got, tt.want)
+		}
+	}
+}
+
+func TestLerpRGBA(t *testing.T) {
+	m := testMixer(t)
+	for _, c := range randomMixCases(300, 6) {
+		a1, a2 := c.rgb1[0]|1, c.rgb2[2]
+		n1 := color.NRGBA{c.rgb1[0], c.rgb1[1], c.rgb1[2], a1}
+		n2 := color.NRGBA{c.rgb2[0], c.rgb2[1], c.rgb2[2], a2}
+		p1 := color.RGBAModel.Convert(n1).(color.RGBA)
+		p2 := color.RGBAModel.Convert(n2).(color.RGBA)
+
+		// The premultiplied inputs unpremultiply to straight colors that
+		// may differ from n1 and n2 by rounding; mix those.
+		s1, _ := unpremultiply8(p1.R, p1.G, p1.B, p1.A)
+		s2, _ := unpremultiply8(p2.R, p2.G, p2.B, p2.A)
+		n := m.LerpNRGBA(withAlpha(s1, a1), withAlpha(s2, a2), c.t)
+		want := color.RGBA{premultiply8(n.R, n.A), premultiply8(n.G, n.A), premultiply8(n.B, n.A), n.A}
+		got := m.LerpRGBA(p1, p2, c.t)
+		if got != want {
+			t.Fatalf("LerpRGBA(%v, %v, %v) = %v, want %v", p1, p2, c.t, got, want)
+		}
+		if got.R > got.A || got.G > got.A || got.B > got.A {
+			t.Fatalf("LerpRGBA(%v, %v, %v) = %v is not a valid premultiplied color", p1, p2, c.t, got)
+		}
+	}
+
+	// Opaque premultiplied colors mix like Lerp.
+	red, blue := color.RGBA{255, 39, 2, 255}, color.RGBA{25, 0, 89, 255}
+	if got, want := m.LerpRGBA(red, blue, 0.5), m.Lerp([3]uint8{255, 39, 2}, [3]uint8{25, 0, 89}, 0.5); [3]uint8{got.R, got.G, got.B} != want || got.A != 255 {
+		t.Errorf("opaque LerpRGBA = %v, want %v", got, want)
+	}
+	// Mixing with transparent leaves the color and scales the coverage.
+	if got := m.LerpRGBA(color.RGBA{128, 20, 1, 128}, color.RGBA{}, 0.5); got != (color.RGBA{64, 10, 1, 64}) {
+		t.Errorf("LerpRGBA with transparent = %v, want {64 10 1 64}", got)
+	}
+}
+
+func rgbOf(c color.NRGBA) [3]uint8 { return [3]uint8{c.R, c.G, c.B} }
+
+func withAlpha(rgb [3]uint8, a uint8) color.NRGBA {
+	return color.NRGBA{rgb[0], rgb[1], rgb[2], a}
+}
@@ -0,0 +1,102 @@
+package mixbox
+
+// Batch conversions work on packed buffers: RGB pixels take three bytes,
+// latents take LatentSize float32 values. Each function processes as many
+// pixels as fit in all of its buffers, returns that count and does not
+// allocate.
+
+// LatentsFromRGB calls Mixer.LatentsFromRGB on the default Mixer.
+func LatentsFromRGB(dst []float32, src []uint8) int {
+	return Default().LatentsFromRGB(dst, src)
+}
+
+// RGBFromLatents calls Mixer.RGBFromLatents on the default Mixer.
+func RGBFromLatents(dst []uint8, src []float32) int {
+	return Default().RGBFromLatents(dst, src)
+}
+
+// LerpSlices calls Mixer.LerpSlices on the default Mixer.
+func LerpSlices(dst, a, b []uint8, t float64) int {
+	return Default().LerpSlices(dst, a, b, t)
+}
+
+// LerpRamp calls Mixer.LerpRamp on the default Mixer.
+func LerpRamp(dst []uint8, rgb1, rgb2 [3]uint8) int {
+	return Default().LerpRamp(dst, rgb1, rgb2)
+}
+
+// LatentsFromRGB converts the RGB pixels in src to latents in dst.
+func (m *Mixer) LatentsFromRGB(dst []float32, src []uint8) int {
+	n := min(len(dst)/LatentSize, len(src)/3)
+	var prev [3]uint8
+	var latent Latent
+	for i := 0; i < n; i++ {
+		rgb := [3]uint8{src[3*i], src[3*i+1], src[3*i+2]}
+		// Neighbouring pixels are often identical; skip the lookup for them.
+		if i == 0 || rgb != prev {
+			latent = m.RGBToLatent(rgb)
+			prev = rgb
+		}
+		out := dst[LatentSize*i : LatentSize*i+LatentSize]
+		for j := range out {
+			out[j] = float32(latent[j])
+		}
+	}
+	return n
+}
+
+// RGBFromLatents converts the latents in src to RGB pixels in dst.
+func (m *Mixer) RGBFromLatents(dst []uint8, src []float32) int {
+	n := min(len(dst)/3, len(src)/LatentSize)
+	for i := 0; i < n; i++ {
+		in := src[LatentSize*i : LatentSize*i+LatentSize]
+		var latent Latent
+		for j := range latent {
+			latent[j] = float64(in[j])
+		}
+		rgb := LatentToRGB(latent)
+		dst[3*i], dst[3*i+1], dst[3*i+2] = rgb[0], rgb[1], rgb[2]
+	}
+	return n
+}
+
+// LerpSlices mixes each pixel of a with the matching pixel of b, writing
+// the same result Lerp would give for ratio t into dst. dst may alias a or b.
+func (m *Mixer) LerpSlices(dst, a, b []uint8, t float64) int {
+	n := min(len(dst), len(a), len(b)) / 3
+	var prevA, prevB [3]uint8
+	var latentA, latentB Latent
+	for i := 0; i < n; i++ {
+		rgbA := [3]uint8{a[3*i], a[3*i+1], a[3*i+2]}
+		rgbB := [3]uint8{b[3*i], b[3*i+1], b[3*i+2]}
+		if i == 0 || rgbA != prevA {
+			latentA = m.RGBToLatent(rgbA)
+			prevA = rgbA
+		}
+		if i == 0 || rgbB != prevB {
+			latentB = m.RGBToLatent(rgbB)
+			prevB = rgbB
+		}
+		rgb := LatentToRGB(latentA.Lerp(latentB, t))
+		dst[3*i], dst[3*i+1], dst[3*i+2] = rgb[0], rgb[1], rgb[2]
+	}
+	return n
+}
+
+// LerpRamp fills dst with a gradient from rgb1 to rgb2: pixel i of n gets
+// Lerp(rgb1, rgb2, i/(n-1)). The two endpoint latents are computed once
+// instead of once per pixel.
+func (m *Mixer) LerpRamp(dst []uint8, rgb1, rgb2 [3]uint8) int {
+	n := len(dst) / 3
+	latent1 := m.RGBToLatent(rgb1)
+	latent2 := m.RGBToLatent(rgb2)
+	for i := 0; i < n; i++ {
+		t := 0.0
+		if n > 1 {
+			t = float64(i) / float64(n-1)
+		}
+		rgb := LatentToRGB(latent1.Lerp(latent2, t))
+		dst[3*i], dst[3*i+1], dst[3*i+2] = rgb[0], rgb[1], rgb[2]
+	}
+	return n
+}
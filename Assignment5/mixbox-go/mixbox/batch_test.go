@@ -0,0 +1,207 @@
+package mixbox
+
+import "testing"
+
+// benchPixels is the number of pixels in each batch buffer.
+const benchPixels = 4096
+
+// photoPixels returns two photo-like RGB buffers of n pixels: short runs of
+// repeated colors.
+func photoPixels(n int) (a, b []uint8) {
+	a = make([]uint8, 3*n)
+	b = make([]uint8, 3*n)
+	for i := 0; i < n; i++ {
+		v := uint8(i / 4)
+		a[3*i], a[3*i+1], a[3*i+2] = v, 255-v, v/2
+		b[3*i], b[3*i+1], b[3*i+2] = 255-v, v/3, v
+	}
+	return a, b
+}
+
+func TestBatchMatchesPerPixel(t *testing.T) {
+	m := testMixer(t)
+	const n = 1000
+	a, b := photoPixels(n)
+	c1, c2 := [3]uint8{254, 236, 0}, [3]uint8{25, 0, 89}
+	pixel := func(s []uint8, i int) [3]uint8 { return [3]uint8{s[3*i], s[3*i+1], s[3*i+2]} }
+
+	latents := make([]float32, LatentSize*n)
+	if got := m.LatentsFromRGB(latents, a); got != n {
+		t.Fatalf("LatentsFromRGB = %d, want %d", got, n)
+	}
+	for i := 0; i < n; i++ {
+		want := m.RGBToLatent(pixel(a, i))
+		for j := range want {
+			if latents[LatentSize*i+j] != float32(want[j]) {
+				t.Fatalf("LatentsFromRGB pixel %d = %v, want %v", i, latents[LatentSize*i:LatentSize*(i+1)], want)
+			}
+		}
+	}
+
+	dst := make([]uint8, 3*n)
+	if got := m.RGBFromLatents(dst, latents); got != n {
+		t.Fatalf("RGBFromLatents = %d, want %d", got, n)
+	}
+	for i := 0; i < n; i++ {
+		var l Latent
+		for j := range l {
+			l[j] = float64(latents[LatentSize*i+j])
+		}
+		if got, want := pixel(dst, i), l.RGB(); got != want {
+			t.Fatalf("RGBFromLatents pixel %d = %v, want %v", i, got, want)
+		}
+	}
+
+	if got := m.LerpSlices(dst, a, b, 0.3); got != n {
+		t.Fatalf("LerpSlices = %d, want %d", got, n)
+	}
+	for i := 0; i < n; i++ {
+		if got, want := pixel(dst, i), m.Lerp(pixel(a, i), pixel(b, i), 0.3); got != want {
+			t.Fatalf("LerpSlices pixel %d = %v, want %v", i, got, want)
+		}
+	}
+
+	if got := m.LerpRamp(dst, c1, c2); got != n {
+		t.Fatalf("LerpRamp = %d, want %d", got, n)
+	}
+	for i := 0; i < n; i++ {
+		if got, want := pixel(dst, i), m.Lerp(c1, c2, float64(i)/(n-1)); got != want {
+			t.Fatalf("LerpRamp pixel %d = %v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestBatchShortBuffers(t *testing.T) {
+	m := testMixer(t)
+	if got := m.LatentsFromRGB(make([]float32, 2*LatentSize), make([]uint8, 3*5)); got != 2 {
+		t.Errorf("LatentsFromRGB = %d, want 2", got)
+	}
+	if got := m.RGBFromLatents(make([]uint8, 7), make([]float32, 5*LatentSize)); got != 2 {
+		t.Errorf("RGBFromLatents = %d, want 2", got)
+	}
+	if got := m.LerpSlices(make([]uint8, 9), make([]uint8, 6), make([]uint8, 12), 0.5); got != 2 {
+		t.Errorf("LerpSlices = %d, want 2", got)
+	}
+	if got := m.LerpRamp(make([]uint8, 4), [3]uint8{}, [3]uint8{}); got != 1 {
+		t.Errorf("LerpRamp = %d, want 1", got)
+	}
+}
+
+func TestBatchAllocs(t *testing.T) {
+	m := testMixer(t)
+	a, b := photoPixels(benchPixels)
+	dst := make([]uint8, 3*benchPixels)
+	latents := make([]float32, LatentSize*benchPixels)
+	for _, tt := range []struct {
+		name string
+		fn   func()
+	}{
+		{"LatentsFromRGB", func() { m.LatentsFromRGB(latents, a) }},
+		{"RGBFromLatents", func() { m.RGBFromLatents(dst, latents) }},
+		{"LerpSlices", func() { m.LerpSlices(dst, a, b, 0.5) }},
+		{"LerpRamp", func() { m.LerpRamp(dst, [3]uint8{254, 236, 0}, [3]uint8{25, 0, 89}) }},
+	} {
+		if allocs := testing.AllocsPerRun(10, tt.fn); allocs != 0 {
+			t.Errorf("%s allocates %v times per call, want 0", tt.name, allocs)
+		}
+	}
+}
+
+// Each benchmark compares the batch function with the per-pixel loop it
+// replaces. Throughput counts the bytes of RGB pixels.
+
+func BenchmarkLatentsFromRGB(b *testing.B) {
+	m := testMixer(b)
+	src, _ := photoPixels(benchPixels)
+	latents := make([]float32, LatentSize*benchPixels)
+	b.Run("batch", func(b *testing.B) {
+		b.SetBytes(int64(len(src)))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			m.LatentsFromRGB(latents, src)
+		}
+	})
+	b.Run("per-pixel", func(b *testing.B) {
+		b.SetBytes(int64(len(src)))
+		for i := 0; i < b.N; i++ {
+			for x := 0; x < benchPixels; x++ {
+				l := m.RGBToLatent([3]uint8{src[3*x], src[3*x+1], src[3*x+2]})
+				for j := range l {
+					latents[LatentSize*x+j] = float32(l[j])
+				}
+			}
+		}
+	})
+}
+
+func BenchmarkRGBFromLatents(b *testing.B) {
+	m := testMixer(b)
+	src, _ := photoPixels(benchPixels)
+	latents := make([]float32, LatentSize*benchPixels)
+	m.LatentsFromRGB(latents, src)
+	dst := make([]uint8, 3*benchPixels)
+	b.Run("batch", func(b *testing.B) {
+		b.SetBytes(int64(len(dst)))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			m.RGBFromLatents(dst, latents)
+		}
+	})
+	b.Run("per-pixel", func(b *testing.B) {
+		b.SetBytes(int64(len(dst)))
+		for i := 0; i < b.N; i++ {
+			for x := 0; x < benchPixels; x++ {
+				var l Latent
+				for j := range l {
+					l[j] = float64(latents[LatentSize*x+j])
+				}
+				rgb := m.LatentToRGB(l)
+				dst[3*x], dst[3*x+1], dst[3*x+2] = rgb[0], rgb[1], rgb[2]
+			}
+		}
+	})
+}
+
+func BenchmarkLerpSlices(b *testing.B) {
+	m := testMixer(b)
+	src, other := photoPixels(benchPixels)
+	dst := make([]uint8, 3*benchPixels)
+	b.Run("batch", func(b *testing.B) {
+		b.SetBytes(int64(len(src)))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			m.LerpSlices(dst, src, other, 0.5)
+		}
+	})
+	b.Run("per-pixel", func(b *testing.B) {
+		b.SetBytes(int64(len(src)))
+		for i := 0; i < b.N; i++ {
+			for x := 0; x < benchPixels; x++ {
+				rgb := m.Lerp([3]uint8{src[3*x], src[3*x+1], src[3*x+2]}, [3]uint8{other[3*x], other[3*x+1], other[3*x+2]}, 0.5)
+				dst[3*x], dst[3*x+1], dst[3*x+2] = rgb[0], rgb[1], rgb[2]
+			}
+		}
+	})
+}
+
+func BenchmarkLerpRamp(b *testing.B) {
+	m := testMixer(b)
+	dst := make([]uint8, 3*benchPixels)
+	c1, c2 := [3]uint8{254, 236, 0}, [3]uint8{25, 0, 89}
+	b.Run("batch", func(b *testing.B) {
+		b.SetBytes(int64(len(dst)))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			m.LerpRamp(dst, c1, c2)
+		}
+	})
+	b.Run("per-pixel", func(b *testing.B) {
+		b.SetBytes(int64(len(dst)))
+		for i := 0; i < b.N; i++ {
+			for x := 0; x < benchPixels; x++ {
+				rgb := m.Lerp(c1, c2, float64(x)/float64(benchPixels-1))
+				dst[3*x], dst[3*x+1], dst[3*x+2] = rgb[0], rgb[1], rgb[2]
+			}
+		}
+	})
+}
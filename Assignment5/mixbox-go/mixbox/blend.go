@@ -0,0 +1,195 @@
+package mixbox
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrImageSize is returned by Blend when its images differ in size.
+var ErrImageSize = errors.New("mixbox: images differ in size")
+
+// BlendOptions configures Blend. The zero value produces 8-bit output using
+// all CPUs.
+type BlendOptions struct {
+	// Mask, if set, scales the mixing ratio per pixel by its gray level:
+	// black keeps the first image, white applies the full ratio.
+	Mask image.Image
+	// Deep selects 16-bit output (*image.NRGBA64) computed from 16-bit
+	// inputs, instead of *image.NRGBA.
+	Deep bool
+	// Workers is the number of goroutines processing rows; zero or less
+	// means runtime.GOMAXPROCS(0).
+	Workers int
+}
+
+// Blend calls Mixer.Blend on the default Mixer.
+func Blend(a, b image.Image, t float64, opts *BlendOptions) (image.Image, error) {
+	return Default().Blend(a, b, t, opts)
+}
+
+// Blend mixes two images pixel by pixel as paint: each output pixel is
+// Lerp(a, b, t) of the corresponding pixels, with t scaled by the mask if
+// one is given. Transparency is handled as in LerpNRGBA: alpha is
+// interpolated linearly and transparent pixels add no color.
+//
+// b and the mask must have the same dimensions as a and are aligned at
+// their top-left corners; an *image.Uniform is accepted for either and
+// covers any size. The result has a's bounds.
+func (m *Mixer) Blend(a, b image.Image, t float64, opts *BlendOptions) (image.Image, error) {
+	if opts == nil {
+		opts = &BlendOptions{}
+	}
+	r := a.Bounds()
+	if !sameSize(r, b) || (opts.Mask != nil && !sameSize(r, opts.Mask)) {
+		return nil, ErrImageSize
+	}
+	t = clamp01(t)
+	bOff := offset(r, b)
+	var mOff image.Point
+	if opts.Mask != nil {
+		mOff = offset(r, opts.Mask)
+	}
+
+	var row func(y int)
+	var out image.Image
+	if opts.Deep {
+		dst := image.NewNRGBA64(r)
+		row = func(y int) { m.blendRow16(dst, a, b, opts.Mask, bOff, mOff, y, t) }
+		out = dst
+	} else {
+		dst := image.NewNRGBA(r)
+		readA, readB, readMask := straight8(a), straight8(b), gray8(opts.Mask)
+		row = func(y int) { m.blendRow8(dst, readA, readB, readMask, bOff, mOff, y, t) }
+		out = dst
+	}
+	forEachRow(r, opts.Workers, row)
+	return out, nil
+}
+
+func sameSize(r image.Rectangle, img image.Image) bool {
+	if _, ok := img.(*image.Uniform); ok {
+		return true
+	}
+	return img.Bounds().Size() == r.Size()
+}
+
+// offset maps coordinates in r to the matching pixel of img.
+func offset(r image.Rectangle, img image.Image) image.Point {
+	if _, ok := img.(*image.Uniform); ok {
+		return image.Point{}
+	}
+	return img.Bounds().Min.Sub(r.Min)
+}
+
+// forEachRow calls fn for every row of r from several goroutines.
+func forEachRow(r image.Rectangle, workers int, fn func(y int)) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = min(workers, r.Dy())
+	var next atomic.Int64
+	next.Store(int64(r.Min.Y))
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				y := int(next.Add(1) - 1)
+				if y >= r.Max.Y {
+					return
+				}
+				fn(y)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func (m *Mixer) blendRow8(dst *image.NRGBA, readA, readB func(x, y int) ([3]uint8, uint8), readMask func(x, y int) uint8, bOff, mOff image.Point, y int, t float64) {
+	// Remember the last conversions; photos and paintings have flat runs.
+	var lastA, lastB [3]uint8
+	var latentA, latentB Latent
+	first := true
+	for x := dst.Rect.Min.X; x < dst.Rect.Max.X; x++ {
+		ca, aa := readA(x, y)
+		cb, ab := readB(x+bOff.X, y+bOff.Y)
+		ratio := t
+		if readMask != nil {
+			ratio *= float64(readMask(x+mOff.X, y+mOff.Y)) / 0xff
+		}
+
+		alpha, share := alphaShare(float64(aa)/0xff, float64(ab)/0xff, ratio)
+		var c [3]uint8
+		switch {
+		case alpha == 0:
+		case share == 0:
+			c = ca
+		case share == 1:
+			c = cb
+		default:
+			if first || ca != lastA {
+				latentA, lastA = m.RGBToLatent(ca), ca
+			}
+			if first || cb != lastB {
+				latentB, lastB = m.RGBToLatent(cb), cb
+			}
+			first = false
+			c = latentA.Lerp(latentB, share).RGB()
+		}
+		i := dst.PixOffset(x, y)
+		p := dst.Pix[i : i+4 : i+4]
+		p[0], p[1], p[2] = c[0], c[1], c[2]
+		p[3] = uint8(alpha*0xff + 0.5)
+	}
+}
+
+func (m *Mixer) blendRow16(dst *image.NRGBA64, a, b, mask image.Image, bOff, mOff image.Point, y int, t float64) {
+	for x := dst.Rect.Min.X; x < dst.Rect.Max.X; x++ {
+		ca, aa := straightFloat(a.At(x, y))
+		cb, ab := straightFloat(b.At(x+bOff.X, y+bOff.Y))
+		ratio := t
+		if mask != nil {
+			ratio *= float64(color.Gray16Model.Convert(mask.At(x+mOff.X, y+mOff.Y)).(color.Gray16).Y) / 0xffff
+		}
+
+		alpha, share := alphaShare(aa, ab, ratio)
+		var c [3]float64
+		switch {
+		case alpha == 0:
+		case share == 0:
+			c = ca
+		case share == 1:
+			c = cb
+		default:
+			c = m.LerpFloat(ca, cb, share)
+		}
+		dst.SetNRGBA64(x, y, color.NRGBA64{
+			R: uint16(clamp01(c[0])*0xffff + 0.5),
+			G: uint16(clamp01(c[1])*0xffff + 0.5),
+			B: uint16(clamp01(c[2])*0xffff + 0.5),
+			A: uint16(alpha*0xffff + 0.5),
+		})
+	}
+}
+
+// gray8 returns a reader for the 8-bit gray level of mask, or nil for a
+// nil mask.
+func gray8(mask image.Image) func(x, y int) uint8 {
+	switch mask := mask.(type) {
+	case nil:
+		return nil
+	case *image.Gray:
+		return func(x, y int) uint8 { return mask.Pix[mask.PixOffset(x, y)] }
+	case *image.Uniform:
+		g := color.GrayModel.Convert(mask.C).(color.Gray).Y
+		return func(x, y int) uint8 { return g }
+	}
+	return func(x, y int) uint8 {
+		return color.GrayModel.Convert(mask.At(x, y)).(color.Gray).Y
+	}
+}
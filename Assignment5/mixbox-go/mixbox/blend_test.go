@@ -0,0 +1,196 @@
+package mixbox
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"testing"
+)
+
+// blendInputs returns two w×h images of opaque, photo-like pixels.
+func blendInputs(w, h int) (a, b *image.NRGBA) {
+	pa, pb := photoPixels(w * h)
+	a = image.NewNRGBA(image.Rect(0, 0, w, h))
+	b = image.NewNRGBA(image.Rect(0, 0, w, h))
+	for i := 0; i < w*h; i++ {
+		copy(a.Pix[4*i:], pa[3*i:3*i+3])
+		copy(b.Pix[4*i:], pb[3*i:3*i+3])
+		a.Pix[4*i+3], b.Pix[4*i+3] = 0xff, 0xff
+	}
+	return a, b
+}
+
+func rgbAt(img *image.NRGBA, x, y int) [3]uint8 {
+	c := img.NRGBAAt(x, y)
+	return [3]uint8{c.R, c.G, c.B}
+}
+
+func TestBlend(t *testing.T) {
+	m := testMixer(t)
+	a, b := blendInputs(37, 23)
+	out, err := m.Blend(a, b, 0.4, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dst := out.(*image.NRGBA)
+	for y := 0; y < 23; y++ {
+		for x := 0; x < 37; x++ {
+			if got, want := rgbAt(dst, x, y), m.Lerp(rgbAt(a, x, y), rgbAt(b, x, y), 0.4); got != want || dst.NRGBAAt(x, y).A != 0xff {
+				t.Fatalf("pixel (%d, %d) = %v alpha %d, want %v opaque", x, y, got, dst.NRGBAAt(x, y).A, want)
+			}
+		}
+	}
+
+	// The result does not depend on how the rows are shared out.
+	one, err := m.Blend(a, b, 0.4, &BlendOptions{Workers: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, v := range one.(*image.NRGBA).Pix {
+		if dst.Pix[i] != v {
+			t.Fatalf("one worker and many differ at byte %d", i)
+		}
+	}
+}
+
+func TestBlendMask(t *testing.T) {
+	m := testMixer(t)
+	a, b := blendInputs(16, 3)
+	// The same mask as *image.Gray, read directly, and as *image.NRGBA,
+	// read through the color model.
+	mask := image.NewGray(image.Rect(0, 0, 16, 3))
+	generic := image.NewNRGBA(mask.Rect)
+	for y := 0; y < 3; y++ {
+		g := []uint8{0, 128, 255}[y]
+		for x := 0; x < 16; x++ {
+			mask.SetGray(x, y, color.Gray{g})
+			generic.SetNRGBA(x, y, color.NRGBA{g, g, g, 0xff})
+		}
+	}
+	for name, mk := range map[string]image.Image{"gray": mask, "generic": generic} {
+		out, err := m.Blend(a, b, 0.8, &BlendOptions{Mask: mk})
+		if err != nil {
+			t.Fatal(err)
+		}
+		dst := out.(*image.NRGBA)
+		for y := 0; y < 3; y++ {
+			ratio := 0.8 * float64(mask.GrayAt(0, y).Y) / 255
+			for x := 0; x < 16; x++ {
+				if got, want := rgbAt(dst, x, y), m.Lerp(rgbAt(a, x, y), rgbAt(b, x, y), ratio); got != want {
+					t.Fatalf("%s mask, pixel (%d, %d) = %v, want %v", name, x, y, got, want)
+				}
+			}
+		}
+		// Black keeps the first image exactly.
+		if got := rgbAt(dst, 5, 0); got != rgbAt(a, 5, 0) {
+			t.Errorf("%s mask: black mask gives %v, want %v", name, got, rgbAt(a, 5, 0))
+		}
+	}
+
+	out, err := m.Blend(a, b, 1, &BlendOptions{Mask: image.NewUniform(color.Gray{0})})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := rgbAt(out.(*image.NRGBA), 3, 1); got != rgbAt(a, 3, 1) {
+		t.Errorf("uniform black mask gives %v, want %v", got, rgbAt(a, 3, 1))
+	}
+}
+
+// 16-bit output agrees with 8-bit output to within one 8-bit step.
+func TestBlendDeep(t *testing.T) {
+	m := testMixer(t)
+	a, b := blendInputs(20, 10)
+	out8, err := m.Blend(a, b, 0.3, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out16, err := m.Blend(a, b, 0.3, &BlendOptions{Deep: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	deep, ok := out16.(*image.NRGBA64)
+	if !ok {
+		t.Fatalf("Deep blend returned %T, want *image.NRGBA64", out16)
+	}
+	for y := 0; y < 10; y++ {
+		for x := 0; x < 20; x++ {
+			c8 := out8.(*image.NRGBA).NRGBAAt(x, y)
+			c16 := deep.NRGBA64At(x, y)
+			for i, pair := range [][2]int{{int(c8.R), int(c16.R)}, {int(c8.G), int(c16.G)}, {int(c8.B), int(c16.B)}, {int(c8.A), int(c16.A)}} {
+				if d := pair[0]*0x101 - pair[1]; d < -0x101 || d > 0x101 {
+					t.Fatalf("pixel (%d, %d) channel %d: 8-bit %#x, 16-bit %#x", x, y, i, pair[0], pair[1])
+				}
+			}
+		}
+	}
+}
+
+func TestBlendTransparent(t *testing.T) {
+	m := testMixer(t)
+	r := image.Rect(0, 0, 4, 1)
+	a, b := image.NewNRGBA(r), image.NewNRGBA(r)
+	red, blue := color.NRGBA{255, 39, 2, 255}, color.NRGBA{25, 0, 89, 255}
+	// Transparent onto opaque, opaque onto transparent, both transparent
+	// and half-covered red onto blue.
+	a.SetNRGBA(0, 0, color.NRGBA{0, 0, 0, 0})
+	b.SetNRGBA(0, 0, blue)
+	a.SetNRGBA(1, 0, red)
+	b.SetNRGBA(1, 0, color.NRGBA{0, 0, 0, 0})
+	a.SetNRGBA(3, 0, color.NRGBA{255, 39, 2, 128})
+	b.SetNRGBA(3, 0, blue)
+
+	for _, deep := range []bool{false, true} {
+		out, err := m.Blend(a, b, 0.25, &BlendOptions{Deep: deep})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for x := 0; x < 4; x++ {
+			want := m.LerpNRGBA(a.NRGBAAt(x, 0), b.NRGBAAt(x, 0), 0.25)
+			got := color.NRGBAModel.Convert(out.At(x, 0)).(color.NRGBA)
+			if deep {
+				// The 16-bit path mixes in float; allow one step.
+				if d := int(got.R) - int(want.R); d < -1 || d > 1 || got.A != want.A {
+					t.Errorf("deep pixel %d = %v, want %v", x, got, want)
+				}
+				continue
+			}
+			if got != want {
+				t.Errorf("pixel %d = %v, want %v", x, got, want)
+			}
+		}
+	}
+}
+
+func TestBlendBounds(t *testing.T) {
+	m := testMixer(t)
+	a, b := blendInputs(8, 8)
+	if _, err := m.Blend(a, b.SubImage(image.Rect(0, 0, 7, 8)), 0.5, nil); !errors.Is(err, ErrImageSize) {
+		t.Errorf("smaller b: err = %v, want ErrImageSize", err)
+	}
+	if _, err := m.Blend(a, b, 0.5, &BlendOptions{Mask: image.NewGray(image.Rect(0, 0, 8, 9))}); !errors.Is(err, ErrImageSize) {
+		t.Errorf("taller mask: err = %v, want ErrImageSize", err)
+	}
+
+	// Images are aligned at their top-left corners, wherever they sit.
+	sub := b.SubImage(image.Rect(2, 3, 6, 7)).(*image.NRGBA)
+	out, err := m.Blend(a.SubImage(image.Rect(4, 4, 8, 8)), sub, 0.5, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dst := out.(*image.NRGBA)
+	if dst.Rect != image.Rect(4, 4, 8, 8) {
+		t.Fatalf("result bounds %v, want a's", dst.Rect)
+	}
+	if got, want := rgbAt(dst, 4, 4), m.Lerp(rgbAt(a, 4, 4), rgbAt(b, 2, 3), 0.5); got != want {
+		t.Errorf("top-left pixel = %v, want %v", got, want)
+	}
+
+	// A uniform b covers any size; t is clamped.
+	out, err = m.Blend(a, image.NewUniform(color.NRGBA{25, 0, 89, 255}), 2, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := rgbAt(out.(*image.NRGBA), 7, 7); got != [3]uint8{25, 0, 89} {
+		t.Errorf("uniform b at t=2 gives %v, want the uniform color", got)
+	}
+}
@@ -0,0 +1,178 @@
+package mixbox
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"runtime"
+	"sync"
+)
+
+// A LatentCache holds the latent of every 8-bit RGB color, so RGBToLatent
+// becomes a single table read instead of a trilinear LUT lookup plus a
+// polynomial evaluation. Each of the seven latent channels is stored as a
+// 16-bit fixed-point number (resolution 2^-14), which keeps results within
+// one 8-bit step of the uncached path; run Verify on a cached Mixer to see
+// the exact deviation.
+//
+// The table covers 256^3 colors at 14 bytes each, about 235 MB. Build it
+// once with BuildLatentCache, save it with WriteTo and map it at startup
+// with OpenLatentCache, which shares the pages between processes and only
+// loads the parts that are touched. BenchmarkLatentsFromRGBCached measures
+// the gain on the current machine.
+type LatentCache struct {
+	file     []byte // header and entries
+	checksum [sha256.Size]byte
+	release  func() error
+}
+
+const (
+	cacheMagic      = "MXBXLC01"
+	cacheHeaderSize = 64
+	cacheEntries    = 256 * 256 * 256
+	cacheEntrySize  = 2 * LatentSize
+	cacheScale      = 1 << 14
+	cacheFileSize   = cacheHeaderSize + cacheEntries*cacheEntrySize
+)
+
+// ErrCacheMismatch is returned when a LatentCache was built from a
+// different lookup table than the Mixer it is attached to.
+var ErrCacheMismatch = errors.New("mixbox: latent cache was built from a different LUT")
+
+// WithLatentCache makes the Mixer answer 8-bit RGBToLatent calls, and
+// everything built on them, from c. The cache must have been built from
+// the same lookup table.
+func WithLatentCache(c *LatentCache) Option {
+	return func(m *Mixer) error {
+		if c == nil {
+			return errors.New("mixbox: nil LatentCache")
+		}
+		if c.checksum != sha256.Sum256(m.lut[:lutDataSize]) {
+			return ErrCacheMismatch
+		}
+		m.cache = c
+		return nil
+	}
+}
+
+// BuildLatentCache computes the latent of every 8-bit color with m, using
+// all available CPUs.
+func BuildLatentCache(m *Mixer) *LatentCache {
+	file := make([]byte, cacheFileSize)
+	c := &LatentCache{file: file, checksum: sha256.Sum256(m.lut[:lutDataSize])}
+	c.writeHeader()
+
+	blues := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < runtime.GOMAXPROCS(0); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for b := range blues {
+				for g := 0; g < 256; g++ {
+					for r := 0; r < 256; r++ {
+						rgb := [3]uint8{uint8(r), uint8(g), uint8(b)}
+						latent := m.FloatRGBToLatent([3]float64{
+							float64(rgb[0]) / 255.0,
+							float64(rgb[1]) / 255.0,
+							float64(rgb[2]) / 255.0,
+						})
+						p := c.entry(rgb)
+						for j, v := range latent {
+							q := math.Round(v * cacheScale)
+							q = math.Max(math.MinInt16, math.Min(math.MaxInt16, q))
+							binary.LittleEndian.PutUint16(p[2*j:], uint16(int16(q)))
+						}
+					}
+				}
+			}
+		}()
+	}
+	for b := 0; b < 256; b++ {
+		blues <- b
+	}
+	close(blues)
+	wg.Wait()
+	return c
+}
+
+// OpenLatentCache maps a cache file written by LatentCache.WriteTo. On
+// systems without mmap support the file is read into memory instead. Close
+// the cache once no Mixer uses it any more.
+func OpenLatentCache(path string) (*LatentCache, error) {
+	file, release, err := mapFile(path)
+	if err != nil {
+		return nil, err
+	}
+	c, err := parseLatentCache(file)
+	if err != nil {
+		release()
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	c.release = release
+	return c, nil
+}
+
+func parseLatentCache(file []byte) (*LatentCache, error) {
+	if len(file) != cacheFileSize {
+		return nil, fmt.Errorf("mixbox: latent cache is %d bytes, want %d", len(file), cacheFileSize)
+	}
+	if !bytes.Equal(file[:8], []byte(cacheMagic)) {
+		return nil, errors.New("mixbox: not a latent cache file")
+	}
+	if binary.LittleEndian.Uint32(file[8:]) != LatentSize || binary.LittleEndian.Uint32(file[12:]) != cacheScale {
+		return nil, errors.New("mixbox: unsupported latent cache layout")
+	}
+	c := &LatentCache{file: file}
+	copy(c.checksum[:], file[16:16+sha256.Size])
+	return c, nil
+}
+
+func (c *LatentCache) writeHeader() {
+	copy(c.file, cacheMagic)
+	binary.LittleEndian.PutUint32(c.file[8:], LatentSize)
+	binary.LittleEndian.PutUint32(c.file[12:], cacheScale)
+	copy(c.file[16:], c.checksum[:])
+}
+
+func (c *LatentCache) entry(rgb [3]uint8) []byte {
+	i := cacheHeaderSize + (int(rgb[2])<<16|int(rgb[1])<<8|int(rgb[0]))*cacheEntrySize
+	return c.file[i : i+cacheEntrySize : i+cacheEntrySize]
+}
+
+// Latent returns the cached latent of rgb.
+func (c *LatentCache) Latent(rgb [3]uint8) Latent {
+	p := c.entry(rgb)
+	var latent Latent
+	for j := range latent {
+		latent[j] = float64(int16(binary.LittleEndian.Uint16(p[2*j:]))) / cacheScale
+	}
+	return latent
+}
+
+// Size returns the number of bytes the cache occupies in memory or on disk.
+func (c *LatentCache) Size() int {
+	return len(c.file)
+}
+
+// WriteTo writes the cache in the format read by OpenLatentCache.
+func (c *LatentCache) WriteTo(w io.Writer) (int64, error) {
+	n, err := w.Write(c.file)
+	return int64(n), err
+}
+
+// Close releases the mapping of a cache opened with OpenLatentCache. The
+// cache, and any Mixer using it, must not be used afterwards.
+func (c *LatentCache) Close() error {
+	if c.release == nil {
+		return nil
+	}
+	err := c.release()
+	c.release = nil
+	c.file = nil
+	return err
+}
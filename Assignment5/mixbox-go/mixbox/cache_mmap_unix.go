@@ -0,0 +1,34 @@
+//go:build unix
+
+package mixbox
+
+import (
+	"os"
+	"syscall"
+)
+
+// mapFile maps path read-only into memory.
+func mapFile(path string) ([]byte, func() error, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, nil, err
+	}
+	size := info.Size()
+	if size == 0 || int64(int(size)) != size {
+		// Let the caller report the size mismatch.
+		data, err := os.ReadFile(path)
+		return data, func() error { return nil }, err
+	}
+
+	data, err := syscall.Mmap(int(f.Fd()), 0, int(size), syscall.PROT_READ, syscall.MAP_SHARED)
+	if err != nil {
+		return nil, nil, &os.PathError{Op: "mmap", Path: path, Err: err}
+	}
+	return data, func() error { return syscall.Munmap(data) }, nil
+}
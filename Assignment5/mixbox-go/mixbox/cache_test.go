@@ -0,0 +1,171 @@
+package mixbox
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+var (
+	testCacheOnce sync.Once
+	testCache     *LatentCache
+)
+
+// builtCache returns a LatentCache over the embedded table, built once per
+// test binary. Building takes seconds and 235 MB, so -short skips it.
+func builtCache(t testing.TB) (*Mixer, *LatentCache) {
+	t.Helper()
+	m := testMixer(t)
+	if testing.Short() {
+		t.Skip("building the latent cache is slow")
+	}
+	testCacheOnce.Do(func() { testCache = BuildLatentCache(m) })
+	return m, testCache
+}
+
+func TestWithLatentCacheErrors(t *testing.T) {
+	m := testMixer(t)
+	if _, err := m.With(WithLatentCache(nil)); err == nil {
+		t.Error("WithLatentCache(nil) succeeded")
+	}
+	if _, err := m.With(WithLatentCache(&LatentCache{})); !errors.Is(err, ErrCacheMismatch) {
+		t.Errorf("cache from another table: err = %v, want ErrCacheMismatch", err)
+	}
+}
+
+func TestLatentCache(t *testing.T) {
+	m, c := builtCache(t)
+	cached, err := m.With(WithLatentCache(c))
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err := Verify(cached)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.MaxDeviation8 > 1 {
+		t.Errorf("cached Mixer: %v, want every mix within one 8-bit step", report)
+	}
+
+	parsed, err := parseLatentCache(c.file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.checksum != c.checksum {
+		t.Error("parsed cache has a different checksum")
+	}
+	if _, err := parseLatentCache(c.file[:cacheHeaderSize]); err == nil {
+		t.Error("parseLatentCache accepted a truncated file")
+	}
+}
+
+// TestOpenLatentCache writes a cache to disk, maps it back in with
+// OpenLatentCache and checks that the mapping answers like the original.
+func TestOpenLatentCache(t *testing.T) {
+	m, c := builtCache(t)
+	path := filepath.Join(t.TempDir(), "mixbox.cache")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := c.WriteTo(f); err != nil || n != int64(c.Size()) {
+		t.Fatalf("WriteTo = %d, %v; want %d bytes", n, err, c.Size())
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	opened, err := OpenLatentCache(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opened.Size() != c.Size() {
+		t.Errorf("opened cache is %d bytes, want %d", opened.Size(), c.Size())
+	}
+	for _, mc := range randomMixCases(20000, 5) {
+		for _, rgb := range [][3]uint8{mc.rgb1, mc.rgb2} {
+			if got, want := opened.Latent(rgb), c.Latent(rgb); got != want {
+				t.Fatalf("opened cache: Latent(%v) = %v, want %v", rgb, got, want)
+			}
+		}
+	}
+	for _, rgb := range [][3]uint8{{0, 0, 0}, {255, 255, 255}, {255, 0, 0}, {0, 0, 255}} {
+		if got, want := opened.Latent(rgb), c.Latent(rgb); got != want {
+			t.Fatalf("opened cache: Latent(%v) = %v, want %v", rgb, got, want)
+		}
+	}
+
+	cached, err := m.With(WithLatentCache(opened))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := cached.Lerp([3]uint8{254, 236, 0}, [3]uint8{25, 0, 89}, 0.5), m.Lerp([3]uint8{254, 236, 0}, [3]uint8{25, 0, 89}, 0.5); got != want {
+		t.Errorf("Mixer on the opened cache: Lerp = %v, want %v", got, want)
+	}
+
+	if err := opened.Close(); err != nil {
+		t.Errorf("Close: %v", err)
+	}
+	if err := opened.Close(); err != nil {
+		t.Errorf("second Close: %v", err)
+	}
+}
+
+func TestOpenLatentCacheErrors(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := OpenLatentCache(filepath.Join(dir, "missing")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("missing file: err = %v, want os.ErrNotExist", err)
+	}
+	for name, data := range map[string][]byte{
+		"empty":      nil,
+		"truncated":  []byte(cacheMagic + "\x07\x00\x00\x00"),
+		"wrong size": make([]byte, 4096),
+	} {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := OpenLatentCache(path); err == nil {
+			t.Errorf("%s: OpenLatentCache succeeded", name)
+		}
+	}
+}
+
+// BenchmarkLatentsFromRGBCached compares LatentsFromRGB with and without a
+// latent cache, on image-like input and on random colors.
+func BenchmarkLatentsFromRGBCached(b *testing.B) {
+	m, c := builtCache(b)
+	cached, err := m.With(WithLatentCache(c))
+	if err != nil {
+		b.Fatal(err)
+	}
+	coherent, _ := photoPixels(benchPixels)
+	random := make([]uint8, len(coherent))
+	state := uint32(2463534242)
+	for i := range random {
+		state ^= state << 13
+		state ^= state >> 17
+		state ^= state << 5
+		random[i] = uint8(state)
+	}
+	latents := make([]float32, LatentSize*benchPixels)
+
+	for _, input := range []struct {
+		name string
+		src  []uint8
+	}{{"coherent", coherent}, {"random", random}} {
+		for _, mixer := range []struct {
+			name string
+			m    *Mixer
+		}{{"computed", m}, {"cached", cached}} {
+			b.Run(input.name+"/"+mixer.name, func(b *testing.B) {
+				b.SetBytes(int64(len(input.src)))
+				for i := 0; i < b.N; i++ {
+					mixer.m.LatentsFromRGB(latents, input.src)
+				}
+			})
+		}
+	}
+}
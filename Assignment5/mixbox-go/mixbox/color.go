@@ -0,0 +1,98 @@
+package mixbox
+
+import (
+	"image/color"
+)
+
+// LatentColor is a color held in latent space, with straight (not
+// premultiplied) alpha. It implements color.Color, so it can be stored in
+// any image, and Color, so it can be passed to Mix.
+type LatentColor struct {
+	Latent Latent
+	A      uint16 // 0xffff is opaque
+}
+
+// RGBA implements color.Color.
+func (c LatentColor) RGBA() (r, g, b, a uint32) {
+	rgb := c.Latent.FloatRGB()
+	a = uint32(c.A)
+	r = uint32(rgb[0]*0xffff+0.5) * a / 0xffff
+	g = uint32(rgb[1]*0xffff+0.5) * a / 0xffff
+	b = uint32(rgb[2]*0xffff+0.5) * a / 0xffff
+	return r, g, b, a
+}
+
+// ToLatent implements Color. The alpha is ignored.
+func (c LatentColor) ToLatent(m *Mixer) Latent {
+	return c.Latent
+}
+
+// StdColor adapts a color.Color for use with Mix. Its alpha is ignored.
+type StdColor struct {
+	color.Color
+}
+
+// ToLatent implements Color.
+func (c StdColor) ToLatent(m *Mixer) Latent {
+	return m.ColorToLatent(c.Color)
+}
+
+// Model converts colors to LatentColor using the default Mixer.
+var Model color.Model = color.ModelFunc(func(c color.Color) color.Color {
+	return Default().toLatentColor(c)
+})
+
+// Model returns a color.Model that converts colors to LatentColor using m.
+func (m *Mixer) Model() color.Model {
+	return color.ModelFunc(m.toLatentColor)
+}
+
+func (m *Mixer) toLatentColor(c color.Color) color.Color {
+	if lc, ok := c.(LatentColor); ok {
+		return lc
+	}
+	rgb, a := straightFloat(c)
+	return LatentColor{Latent: m.FloatRGBToLatent(rgb), A: uint16(a*0xffff + 0.5)}
+}
+
+// ColorToLatent calls Mixer.ColorToLatent on the default Mixer.
+func ColorToLatent(c color.Color) Latent {
+	return Default().ColorToLatent(c)
+}
+
+// ColorToLatent converts any color.Color to latent space, undoing its
+// premultiplied alpha first. The alpha itself is dropped.
+func (m *Mixer) ColorToLatent(c color.Color) Latent {
+	if lc, ok := c.(LatentColor); ok {
+		return lc.Latent
+	}
+	rgb, _ := straightFloat(c)
+	return m.FloatRGBToLatent(rgb)
+}
+
+// RGBFromColor returns c as an 8-bit straight-alpha sRGB triple, the
+// input format of Lerp.
+func RGBFromColor(c color.Color) [3]uint8 {
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	return [3]uint8{n.R, n.G, n.B}
+}
+
+// ColorFromRGB returns an opaque color.NRGBA for an 8-bit sRGB triple, such
+// as the result of Lerp.
+func ColorFromRGB(rgb [3]uint8) color.NRGBA {
+	return color.NRGBA{R: rgb[0], G: rgb[1], B: rgb[2], A: 0xff}
+}
+
+// LerpColor calls Mixer.LerpColor on the default Mixer.
+func LerpColor(c1, c2 color.Color, t float64) LatentColor {
+	return Default().LerpColor(c1, c2, t)
+}
+
+// LerpColor mixes two arbitrary colors with 16 bits of precision per
+// channel. Alpha is ignored and the result is opaque.
+func (m *Mixer) LerpColor(c1, c2 color.Color, t float64) LatentColor {
+	return LatentColor{
+		Latent: m.ColorToLatent(c1).Lerp(m.ColorToLatent(c2), t),
+		A:      0xffff,
+	}
+}
@@ -0,0 +1,117 @@
+package mixbox
+
+import (
+	"image/color"
+	"testing"
+)
+
+func TestLatentColorRGBA(t *testing.T) {
+	m := testMixer(t)
+	for _, rgb := range [][3]uint8{{0, 0, 0}, {255, 255, 255}, {254, 236, 0}, {25, 0, 89}} {
+		l := m.RGBToLatent(rgb)
+		for _, a := range []uint16{0, 1, 0x7fff, 0xfffe, 0xffff} {
+			r, g, b, ca := LatentColor{l, a}.RGBA()
+			if ca != uint32(a) {
+				t.Errorf("%v alpha %#x: RGBA alpha %#x", rgb, a, ca)
+			}
+			// color.Color requires premultiplied channels no larger than alpha.
+			if r > ca || g > ca || b > ca {
+				t.Errorf("%v alpha %#x: RGBA() = %#x, %#x, %#x, %#x exceeds alpha", rgb, a, r, g, b, ca)
+			}
+		}
+		// Opaque, the 16-bit channels narrow to the 8-bit color.
+		r, g, b, _ := LatentColor{l, 0xffff}.RGBA()
+		if got := [3]uint8{uint8(r >> 8), uint8(g >> 8), uint8(b >> 8)}; got != l.RGB() {
+			t.Errorf("%v: opaque RGBA narrows to %v, want %v", rgb, got, l.RGB())
+		}
+	}
+}
+
+func TestModel(t *testing.T) {
+	m := testMixer(t)
+	model := m.Model()
+	for _, tt := range []struct {
+		name string
+		in   color.Color
+		rgb  [3]uint8
+		a    uint16
+	}{
+		{"opaque", color.NRGBA{254, 236, 0, 255}, [3]uint8{254, 236, 0}, 0xffff},
+		{"straight alpha", color.NRGBA{200, 100, 50, 128}, [3]uint8{200, 100, 50}, 128 * 0x101},
+		{"premultiplied alpha", color.RGBA{100, 50, 25, 128}, [3]uint8{199, 100, 50}, 128 * 0x101},
+		{"16-bit", color.RGBA64{0xffff, 0, 0x8080, 0xffff}, [3]uint8{255, 0, 128}, 0xffff},
+		{"gray", color.Gray{77}, [3]uint8{77, 77, 77}, 0xffff},
+		{"transparent", color.NRGBA{}, [3]uint8{}, 0},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			lc, ok := model.Convert(tt.in).(LatentColor)
+			if !ok {
+				t.Fatalf("Convert returned %T, want LatentColor", model.Convert(tt.in))
+			}
+			if lc.A != tt.a {
+				t.Errorf("alpha %#x, want %#x", lc.A, tt.a)
+			}
+			if got := lc.Latent.RGB(); got != tt.rgb {
+				t.Errorf("color %v, want %v", got, tt.rgb)
+			}
+			if again := model.Convert(lc); again != lc {
+				t.Errorf("converting a LatentColor changed it: %v, want %v", again, lc)
+			}
+		})
+	}
+}
+
+func TestPackageModelUsesDefault(t *testing.T) {
+	m := testMixer(t)
+	prev := Default()
+	defer SetDefault(prev)
+	SetDefault(m)
+	c := color.NRGBA{12, 200, 99, 255}
+	if got, want := Model.Convert(c), m.Model().Convert(c); got != want {
+		t.Errorf("Model.Convert = %v, want %v", got, want)
+	}
+}
+
+func TestColorToLatent(t *testing.T) {
+	m := testMixer(t)
+	rgb := [3]uint8{123, 72, 0}
+	want := m.RGBToLatent(rgb)
+	for _, c := range []color.Color{
+		color.NRGBA{rgb[0], rgb[1], rgb[2], 255},
+		color.NRGBA{rgb[0], rgb[1], rgb[2], 64},
+		ColorFromRGB(rgb),
+		LatentColor{want, 0x1234},
+	} {
+		if got := m.ColorToLatent(c).RGB(); got != rgb {
+			t.Errorf("ColorToLatent(%v) mixes to %v, want %v", c, got, rgb)
+		}
+		if got := (StdColor{c}).ToLatent(m); got != m.ColorToLatent(c) {
+			t.Errorf("StdColor{%v}.ToLatent = %v, want ColorToLatent's %v", c, got, m.ColorToLatent(c))
+		}
+	}
+	if got := RGBFromColor(ColorFromRGB(rgb)); got != rgb {
+		t.Errorf("RGBFromColor(ColorFromRGB(%v)) = %v", rgb, got)
+	}
+}
+
+func TestLerpColorMatchesLerp(t *testing.T) {
+	m := testMixer(t)
+	for _, c := range randomMixCases(500, 4) {
+		lc := m.LerpColor(ColorFromRGB(c.rgb1), ColorFromRGB(c.rgb2), c.t)
+		if lc.A != 0xffff {
+			t.Fatalf("LerpColor alpha %#x, want opaque", lc.A)
+		}
+		got, want := lc.Latent.RGB(), m.Lerp(c.rgb1, c.rgb2, c.t)
+		for i := range got {
+			if d := int(got[i]) - int(want[i]); d < -1 || d > 1 {
+				t.Fatalf("LerpColor(%v, %v, %v) = %v, Lerp gives %v", c.rgb1, c.rgb2, c.t, got, want)
+			}
+		}
+	}
+	// Alpha is ignored, not mixed in.
+	a := m.LerpColor(color.NRGBA{254, 236, 0, 10}, color.NRGBA{25, 0, 89, 255}, 0.5)
+	b := m.LerpColor(color.NRGBA{254, 236, 0, 255}, color.NRGBA{25, 0, 89, 255}, 0.5)
+	if a.Latent.RGB() != b.Latent.RGB() {
+		t.Errorf("translucent input mixes to %v, opaque to %v", a.Latent.RGB(), b.Latent.RGB())
+	}
+}
@@ -0,0 +1,348 @@
+package mixbox
+
+import (
+	"errors"
+	"math"
+)
+
+// A ColorSpace describes an RGB space by its primaries, white point and
+// transfer function, so colors given in it can be mixed. The Mixbox lookup
+// table works in sRGB: inputs are converted to sRGB, gamut mapped if they
+// fall outside it, mixed, and converted back. Since pigment mixes are
+// modelled within sRGB, results never leave it, even in a wider space.
+//
+// A ColorSpace is immutable and safe for concurrent use.
+type ColorSpace struct {
+	Name     string
+	transfer Transfer
+	mapping  GamutMapping
+	toSRGB   mat3 // linear RGB in this space to linear sRGB
+	fromSRGB mat3
+}
+
+// Primaries holds the CIE xy chromaticities of an RGB space's primaries and
+// white point.
+type Primaries struct {
+	R, G, B, White [2]float64
+}
+
+// Transfer converts between encoded and linear channel values.
+type Transfer struct {
+	ToLinear   func(float64) float64
+	FromLinear func(float64) float64
+}
+
+// GamutMapping selects how colors outside the sRGB gamut are brought into
+// it before mixing.
+type GamutMapping int
+
+const (
+	// GamutCompress keeps the luminance and reduces chroma, moving the
+	// color toward the gray of the same luminance until it fits. Hue is
+	// approximately preserved.
+	GamutCompress GamutMapping = iota
+	// GamutClip clips each linear channel to 0..1, which is cheaper but
+	// shifts hue and lightness.
+	GamutClip
+)
+
+// D65 is the white point of all predefined color spaces.
+var D65 = [2]float64{0.3127, 0.3290}
+
+var (
+	// TransferSRGB is the sRGB curve, also used by Display P3.
+	TransferSRGB = Transfer{srgbToLinear, linearToSrgb}
+	// TransferLinear leaves values unchanged.
+	TransferLinear = Transfer{func(x float64) float64 { return x }, func(x float64) float64 { return x }}
+	// TransferRec2020 is the ITU-R BT.2020 curve.
+	TransferRec2020 = Transfer{rec2020ToLinear, linearToRec2020}
+	// TransferAdobeRGB is the Adobe RGB (1998) gamma of 563/256.
+	TransferAdobeRGB = TransferGamma(563.0 / 256.0)
+)
+
+// TransferGamma returns a pure power-law transfer function.
+func TransferGamma(gamma float64) Transfer {
+	return Transfer{
+		func(x float64) float64 { return math.Pow(x, gamma) },
+		func(x float64) float64 { return math.Pow(x, 1/gamma) },
+	}
+}
+
+func rec2020ToLinear(x float64) float64 {
+	const alpha, beta = 1.09929682680944, 0.018053968510807
+	if x < 4.5*beta {
+		return x / 4.5
+	}
+	return math.Pow((x+alpha-1)/alpha, 1/0.45)
+}
+
+func linearToRec2020(x float64) float64 {
+	const alpha, beta = 1.09929682680944, 0.018053968510807
+	if x < beta {
+		return 4.5 * x
+	}
+	return alpha*math.Pow(x, 0.45) - (alpha - 1)
+}
+
+var srgbPrimaries = Primaries{
+	R:     [2]float64{0.64, 0.33},
+	G:     [2]float64{0.30, 0.60},
+	B:     [2]float64{0.15, 0.06},
+	White: D65,
+}
+
+// The predefined color spaces, with the names CSS Color 4 uses for them.
+var (
+	SRGB       = mustColorSpace("srgb", srgbPrimaries, TransferSRGB)
+	LinearSRGB = mustColorSpace("srgb-linear", srgbPrimaries, TransferLinear)
+	DisplayP3  = mustColorSpace("display-p3", Primaries{
+		R:     [2]float64{0.680, 0.320},
+		G:     [2]float64{0.265, 0.690},
+		B:     [2]float64{0.150, 0.060},
+		White: D65,
+	}, TransferSRGB)
+	Rec2020 = mustColorSpace("rec2020", Primaries{
+		R:     [2]float64{0.708, 0.292},
+		G:     [2]float64{0.170, 0.797},
+		B:     [2]float64{0.131, 0.046},
+		White: D65,
+	}, TransferRec2020)
+	AdobeRGB = mustColorSpace("a98-rgb", Primaries{
+		R:     [2]float64{0.64, 0.33},
+		G:     [2]float64{0.21, 0.71},
+		B:     [2]float64{0.15, 0.06},
+		White: D65,
+	}, TransferAdobeRGB)
+)
+
+// ColorSpaces lists the predefined color spaces.
+var ColorSpaces = []*ColorSpace{SRGB, LinearSRGB, DisplayP3, Rec2020, AdobeRGB}
+
+// ColorSpaceByName returns the predefined color space with the given name.
+func ColorSpaceByName(name string) (*ColorSpace, bool) {
+	for _, cs := range ColorSpaces {
+		if cs.Name == name {
+			return cs, true
+		}
+	}
+	return nil, false
+}
+
+var errPrimaries = errors.New("mixbox: degenerate color space primaries")
+
+// NewColorSpace builds a color space from its primaries and transfer
+// function. A white point other than D65 is adapted with the Bradford
+// transform.
+func NewColorSpace(name string, p Primaries, tf Transfer) (*ColorSpace, error) {
+	toXYZ, ok := rgbToXYZ(p)
+	if !ok {
+		return nil, errPrimaries
+	}
+	srgbToXYZ, _ := rgbToXYZ(srgbPrimaries)
+	xyzToSRGB, _ := srgbToXYZ.inverse()
+	if p.White != D65 {
+		toXYZ = bradford(p.White, D65).mul(toXYZ)
+	}
+	toSRGB := xyzToSRGB.mul(toXYZ)
+	fromSRGB, ok := toSRGB.inverse()
+	if !ok {
+		return nil, errPrimaries
+	}
+	return &ColorSpace{Name: name, transfer: tf, toSRGB: toSRGB, fromSRGB: fromSRGB}, nil
+}
+
+func mustColorSpace(name string, p Primaries, tf Transfer) *ColorSpace {
+	cs, err := NewColorSpace(name, p, tf)
+	if err != nil {
+		panic(err)
+	}
+	return cs
+}
+
+// WithGamutMapping returns a copy of cs that maps out-of-gamut colors with
+// g. The default is GamutCompress.
+func (cs *ColorSpace) WithGamutMapping(g GamutMapping) *ColorSpace {
+	c := *cs
+	c.mapping = g
+	return &c
+}
+
+// ToSRGB converts an encoded color in cs, with channels clamped to 0..1, to
+// encoded sRGB, gamut mapping it if needed. The second result reports
+// whether the color was inside the sRGB gamut.
+func (cs *ColorSpace) ToSRGB(rgb [3]float64) ([3]float64, bool) {
+	var lin [3]float64
+	for i, v := range rgb {
+		lin[i] = cs.transfer.ToLinear(clamp01(v))
+	}
+	lin = cs.toSRGB.apply(lin)
+	inGamut := true
+	for _, v := range lin {
+		// Allow for rounding in the matrices.
+		if v < -1e-9 || v > 1+1e-9 {
+			inGamut = false
+		}
+	}
+	if !inGamut {
+		lin = mapGamut(lin, cs.mapping)
+	}
+	return [3]float64{linearToSrgb(clamp01(lin[0])), linearToSrgb(clamp01(lin[1])), linearToSrgb(clamp01(lin[2]))}, inGamut
+}
+
+// FromSRGB converts an encoded sRGB color to encoded values in cs.
+func (cs *ColorSpace) FromSRGB(srgb [3]float64) [3]float64 {
+	lin := cs.fromSRGB.apply([3]float64{srgbToLinear(clamp01(srgb[0])), srgbToLinear(clamp01(srgb[1])), srgbToLinear(clamp01(srgb[2]))})
+	return [3]float64{
+		cs.transfer.FromLinear(clamp01(lin[0])),
+		cs.transfer.FromLinear(clamp01(lin[1])),
+		cs.transfer.FromLinear(clamp01(lin[2])),
+	}
+}
+
+// mapGamut brings a linear sRGB color into 0..1.
+func mapGamut(lin [3]float64, g GamutMapping) [3]float64 {
+	if g == GamutClip {
+		return [3]float64{clamp01(lin[0]), clamp01(lin[1]), clamp01(lin[2])}
+	}
+	y := clamp01(0.2126*lin[0] + 0.7152*lin[1] + 0.0722*lin[2])
+	// Largest s in 0..1 keeping y + s*(c-y) inside 0..1 for every channel.
+	s := 1.0
+	for _, c := range lin {
+		switch {
+		case c > 1:
+			s = math.Min(s, (1-y)/(c-y))
+		case c < 0:
+			s = math.Min(s, y/(y-c))
+		}
+	}
+	var out [3]float64
+	for i, c := range lin {
+		out[i] = clamp01(y + s*(c-y))
+	}
+	return out
+}
+
+// SpaceRGB is a color in a ColorSpace, with encoded channels in 0..1. It
+// implements Color, so it can be passed to Mix.
+type SpaceRGB struct {
+	Space *ColorSpace
+	RGB   [3]float64
+}
+
+// ToLatent implements Color.
+func (c SpaceRGB) ToLatent(m *Mixer) Latent {
+	return m.SpaceRGBToLatent(c.Space, c.RGB)
+}
+
+// SpaceRGBToLatent calls Mixer.SpaceRGBToLatent on the default Mixer.
+func SpaceRGBToLatent(cs *ColorSpace, rgb [3]float64) Latent {
+	return Default().SpaceRGBToLatent(cs, rgb)
+}
+
+// LatentToSpaceRGB converts a latent to encoded values in cs.
+func LatentToSpaceRGB(cs *ColorSpace, latent Latent) [3]float64 {
+	return cs.FromSRGB(LatentToFloatRGB(latent))
+}
+
+// LerpSpace calls Mixer.LerpSpace on the default Mixer.
+func LerpSpace(cs *ColorSpace, rgb1, rgb2 [3]float64, t float64) [3]float64 {
+	return Default().LerpSpace(cs, rgb1, rgb2, t)
+}
+
+// SpaceRGBToLatent converts a color given in cs to latent space.
+func (m *Mixer) SpaceRGBToLatent(cs *ColorSpace, rgb [3]float64) Latent {
+	srgb, _ := cs.ToSRGB(rgb)
+	return m.FloatRGBToLatent(srgb)
+}
+
+// LatentToSpaceRGB converts a latent to encoded values in cs.
+func (m *Mixer) LatentToSpaceRGB(cs *ColorSpace, latent Latent) [3]float64 {
+	return LatentToSpaceRGB(cs, latent)
+}
+
+// LerpSpace mixes two colors given in cs and returns the result in cs.
+func (m *Mixer) LerpSpace(cs *ColorSpace, rgb1, rgb2 [3]float64, t float64) [3]float64 {
+	return LatentToSpaceRGB(cs, m.SpaceRGBToLatent(cs, rgb1).Lerp(m.SpaceRGBToLatent(cs, rgb2), t))
+}
+
+// mat3 is a 3×3 matrix in row-major order.
+type mat3 [3][3]float64
+
+func (a mat3) mul(b mat3) mat3 {
+	var c mat3
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			c[i][j] = a[i][0]*b[0][j] + a[i][1]*b[1][j] + a[i][2]*b[2][j]
+		}
+	}
+	return c
+}
+
+func (a mat3) apply(v [3]float64) [3]float64 {
+	return [3]float64{
+		a[0][0]*v[0] + a[0][1]*v[1] + a[0][2]*v[2],
+		a[1][0]*v[0] + a[1][1]*v[1] + a[1][2]*v[2],
+		a[2][0]*v[0] + a[2][1]*v[1] + a[2][2]*v[2],
+	}
+}
+
+func (a mat3) inverse() (mat3, bool) {
+	det := a[0][0]*(a[1][1]*a[2][2]-a[1][2]*a[2][1]) -
+		a[0][1]*(a[1][0]*a[2][2]-a[1][2]*a[2][0]) +
+		a[0][2]*(a[1][0]*a[2][1]-a[1][1]*a[2][0])
+	if math.Abs(det) < 1e-12 {
+		return mat3{}, false
+	}
+	var inv mat3
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			// Cofactor of a[j][i], using cyclic indices to fold in the sign.
+			r0, r1 := (j+1)%3, (j+2)%3
+			c0, c1 := (i+1)%3, (i+2)%3
+			inv[i][j] = (a[r0][c0]*a[r1][c1] - a[r0][c1]*a[r1][c0]) / det
+		}
+	}
+	return inv, true
+}
+
+// xyToXYZ returns the XYZ of chromaticity xy at luminance 1.
+func xyToXYZ(xy [2]float64) [3]float64 {
+	return [3]float64{xy[0] / xy[1], 1, (1 - xy[0] - xy[1]) / xy[1]}
+}
+
+// rgbToXYZ returns the matrix from linear RGB with primaries p to XYZ,
+// scaled so that RGB white maps to the white point with Y = 1.
+func rgbToXYZ(p Primaries) (mat3, bool) {
+	r, g, b := xyToXYZ(p.R), xyToXYZ(p.G), xyToXYZ(p.B)
+	m := mat3{
+		{r[0], g[0], b[0]},
+		{r[1], g[1], b[1]},
+		{r[2], g[2], b[2]},
+	}
+	inv, ok := m.inverse()
+	if !ok {
+		return mat3{}, false
+	}
+	s := inv.apply(xyToXYZ(p.White))
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			m[i][j] *= s[j]
+		}
+	}
+	return m, true
+}
+
+// bradford returns the Bradford chromatic adaptation from white point src
+// to dst, acting on XYZ.
+func bradford(src, dst [2]float64) mat3 {
+	cone := mat3{
+		{0.8951, 0.2664, -0.1614},
+		{-0.7502, 1.7135, 0.0367},
+		{0.0389, -0.0685, 1.0296},
+	}
+	coneInv, _ := cone.inverse()
+	s := cone.apply(xyToXYZ(src))
+	d := cone.apply(xyToXYZ(dst))
+	scale := mat3{{d[0] / s[0], 0, 0}, {0, d[1] / s[1], 0}, {0, 0, d[2] / s[2]}}
+	return coneInv.mul(scale).mul(cone)
+}
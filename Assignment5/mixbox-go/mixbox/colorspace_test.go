@@ -0,0 +1,184 @@
+package mixbox
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func near3(a, b [3]float64, tol float64) bool {
+	for i := range a {
+		if math.Abs(a[i]-b[i]) > tol {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSRGBTransfer(t *testing.T) {
+	for _, tt := range []struct{ encoded, linear float64 }{
+		{0, 0}, {0.04045, 0.04045 / 12.92}, {0.5, 0.21404114}, {1, 1},
+	} {
+		if got := srgbToLinear(tt.encoded); math.Abs(got-tt.linear) > 1e-8 {
+			t.Errorf("srgbToLinear(%v) = %v, want %v", tt.encoded, got, tt.linear)
+		}
+	}
+	for i := 0; i <= 1000; i++ {
+		x := float64(i) / 1000
+		if got := srgbToLinear(linearToSrgb(x)); math.Abs(got-x) > 1e-12 {
+			t.Fatalf("srgbToLinear(linearToSrgb(%v)) = %v", x, got)
+		}
+		if got := rec2020ToLinear(linearToRec2020(x)); math.Abs(got-x) > 1e-12 {
+			t.Fatalf("rec2020ToLinear(linearToRec2020(%v)) = %v", x, got)
+		}
+	}
+}
+
+// rgbToXYZ reproduces the sRGB matrix of IEC 61966-2-1.
+func TestRGBToXYZ(t *testing.T) {
+	m, ok := rgbToXYZ(srgbPrimaries)
+	if !ok {
+		t.Fatal("sRGB primaries are degenerate")
+	}
+	want := mat3{
+		{0.4124, 0.3576, 0.1805},
+		{0.2126, 0.7152, 0.0722},
+		{0.0193, 0.1192, 0.9505},
+	}
+	for i := range m {
+		if !near3(m[i], want[i], 1e-4) {
+			t.Errorf("row %d = %v, want %v", i, m[i], want[i])
+		}
+	}
+	if white := m.apply([3]float64{1, 1, 1}); !near3(white, xyToXYZ(D65), 1e-12) {
+		t.Errorf("RGB white maps to %v, want D65 %v", white, xyToXYZ(D65))
+	}
+	inv, ok := m.inverse()
+	if !ok {
+		t.Fatal("sRGB matrix is singular")
+	}
+	id := m.mul(inv)
+	for i := range id {
+		want := [3]float64{}
+		want[i] = 1
+		if !near3(id[i], want, 1e-12) {
+			t.Errorf("m × m⁻¹ row %d = %v", i, id[i])
+		}
+	}
+}
+
+func TestColorSpaceRoundTrip(t *testing.T) {
+	var colors [][3]float64
+	for r := 0.0; r <= 1; r += 0.25 {
+		for g := 0.0; g <= 1; g += 0.25 {
+			for b := 0.0; b <= 1; b += 0.25 {
+				colors = append(colors, [3]float64{r, g, b})
+			}
+		}
+	}
+	for _, cs := range ColorSpaces {
+		// Every sRGB color is inside each of the wider spaces, so it makes
+		// the round trip through them unchanged.
+		for _, srgb := range colors {
+			got, inGamut := cs.ToSRGB(cs.FromSRGB(srgb))
+			if !inGamut || !near3(got, srgb, 1e-9) {
+				t.Errorf("%s: %v comes back as %v (in gamut %v)", cs.Name, srgb, got, inGamut)
+			}
+		}
+	}
+	for _, c := range colors {
+		if got, _ := SRGB.ToSRGB(c); !near3(got, c, 1e-12) {
+			t.Errorf("srgb: ToSRGB(%v) = %v", c, got)
+		}
+		lin := [3]float64{linearToSrgb(c[0]), linearToSrgb(c[1]), linearToSrgb(c[2])}
+		if got, _ := LinearSRGB.ToSRGB(c); !near3(got, lin, 1e-12) {
+			t.Errorf("srgb-linear: ToSRGB(%v) = %v, want %v", c, got, lin)
+		}
+	}
+}
+
+// sRGB red in Display P3, as given in CSS Color 4.
+func TestDisplayP3(t *testing.T) {
+	if got := DisplayP3.FromSRGB([3]float64{1, 0, 0}); !near3(got, [3]float64{0.9175, 0.2003, 0.1386}, 1e-4) {
+		t.Errorf("sRGB red in Display P3 = %v", got)
+	}
+}
+
+func TestGamutMapping(t *testing.T) {
+	for _, cs := range []*ColorSpace{DisplayP3, Rec2020, AdobeRGB} {
+		for _, primary := range [][3]float64{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}, {0, 1, 1}} {
+			if cs == AdobeRGB && (primary == [3]float64{1, 0, 0} || primary == [3]float64{0, 0, 1}) {
+				continue // Adobe RGB shares sRGB's red and blue primaries
+			}
+			for _, g := range []GamutMapping{GamutCompress, GamutClip} {
+				got, inGamut := cs.WithGamutMapping(g).ToSRGB(primary)
+				if inGamut {
+					t.Errorf("%s %v: reported inside the sRGB gamut", cs.Name, primary)
+				}
+				for _, v := range got {
+					if v < 0 || v > 1 || math.IsNaN(v) {
+						t.Errorf("%s %v, mapping %d: %v is outside 0..1", cs.Name, primary, g, got)
+					}
+				}
+			}
+		}
+	}
+
+	// GamutCompress keeps the luminance and the dominant channel.
+	got, _ := DisplayP3.ToSRGB([3]float64{0, 1, 0})
+	lum := func(srgb [3]float64) float64 {
+		return 0.2126*srgbToLinear(srgb[0]) + 0.7152*srgbToLinear(srgb[1]) + 0.0722*srgbToLinear(srgb[2])
+	}
+	p3Green := DisplayP3.toSRGB.apply([3]float64{0, 1, 0})
+	if want := 0.2126*p3Green[0] + 0.7152*p3Green[1] + 0.0722*p3Green[2]; math.Abs(lum(got)-want) > 1e-9 {
+		t.Errorf("compressed P3 green has luminance %v, want %v", lum(got), want)
+	}
+	if got[1] < got[0] || got[1] < got[2] {
+		t.Errorf("compressed P3 green %v is no longer green", got)
+	}
+}
+
+func TestNewColorSpace(t *testing.T) {
+	// With a D50 white point, white is adapted to sRGB's D65 white.
+	d50 := srgbPrimaries
+	d50.White = [2]float64{0.3457, 0.3585}
+	cs, err := NewColorSpace("srgb-d50", d50, TransferSRGB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := cs.ToSRGB([3]float64{1, 1, 1}); !near3(got, [3]float64{1, 1, 1}, 1e-6) {
+		t.Errorf("D50 white maps to %v, want sRGB white", got)
+	}
+	if id := bradford(D65, D65); !near3(id[0], [3]float64{1, 0, 0}, 1e-12) || !near3(id[1], [3]float64{0, 1, 0}, 1e-12) {
+		t.Errorf("Bradford from D65 to D65 = %v, want the identity", id)
+	}
+
+	line := Primaries{R: [2]float64{0.3, 0.3}, G: [2]float64{0.4, 0.4}, B: [2]float64{0.5, 0.5}, White: D65}
+	if _, err := NewColorSpace("line", line, TransferSRGB); !errors.Is(err, errPrimaries) {
+		t.Errorf("collinear primaries: err = %v, want errPrimaries", err)
+	}
+
+	for _, want := range ColorSpaces {
+		if got, ok := ColorSpaceByName(want.Name); !ok || got != want {
+			t.Errorf("ColorSpaceByName(%q) = %v, %v", want.Name, got, ok)
+		}
+	}
+	if _, ok := ColorSpaceByName("prophoto"); ok {
+		t.Error(`ColorSpaceByName("prophoto") found a space`)
+	}
+}
+
+func TestLerpSpace(t *testing.T) {
+	m := testMixer(t)
+	a, b := [3]float64{1, 0.9, 0}, [3]float64{0.1, 0, 0.35}
+	if got, want := m.LerpSpace(SRGB, a, b, 0.5), m.LerpFloat(a, b, 0.5); !near3(got, want, 1e-9) {
+		t.Errorf("LerpSpace(srgb) = %v, LerpFloat gives %v", got, want)
+	}
+	p3a, p3b := DisplayP3.FromSRGB(a), DisplayP3.FromSRGB(b)
+	if got, want := m.LerpSpace(DisplayP3, p3a, p3b, 0.5), DisplayP3.FromSRGB(m.LerpFloat(a, b, 0.5)); !near3(got, want, 1e-9) {
+		t.Errorf("LerpSpace(display-p3) = %v, want %v", got, want)
+	}
+	if got := (SpaceRGB{DisplayP3, p3a}).ToLatent(m); !near3(got.FloatRGB(), m.FloatRGBToLatent(a).FloatRGB(), 1e-9) {
+		t.Errorf("SpaceRGB.ToLatent decodes to %v, want %v", got.FloatRGB(), a)
+	}
+}
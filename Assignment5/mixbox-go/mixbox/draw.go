@@ -0,0 +1,243 @@
+package mixbox
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+)
+
+// PigmentDrawer composites a source image onto a destination like
+// draw.Over, except that where the two overlap their colors are mixed as
+// paint in latent space instead of being blended additively. Coverage is
+// combined as in Porter-Duff over; the source color's share of the mix is
+// its share of the resulting coverage, so a source pixel at alpha a over an
+// opaque destination gives Lerp(dst, src, a).
+//
+// The source and mask must not overlap the destination pixels being drawn.
+type PigmentDrawer struct {
+	// Mixer mixes the colors; nil means the default Mixer.
+	Mixer *Mixer
+}
+
+// PigmentOver is a PigmentDrawer using the default Mixer.
+var PigmentOver = PigmentDrawer{}
+
+func (d PigmentDrawer) mixer() *Mixer {
+	if d.Mixer != nil {
+		return d.Mixer
+	}
+	return Default()
+}
+
+// Draw implements draw.Drawer.
+func (d PigmentDrawer) Draw(dst draw.Image, r image.Rectangle, src image.Image, sp image.Point) {
+	d.DrawMask(dst, r, src, sp, nil, image.Point{})
+}
+
+// DrawMask composites src onto dst through mask, which scales the source
+// alpha. A nil mask is treated as fully opaque.
+func (d PigmentDrawer) DrawMask(dst draw.Image, r image.Rectangle, src image.Image, sp image.Point, mask image.Image, mp image.Point) {
+	clip(dst, &r, src, &sp, mask, &mp)
+	if r.Empty() {
+		return
+	}
+
+	m := d.mixer()
+	readSrc := straight8(src)
+	readMask := alpha8(mask)
+	var readDst func(x, y int) ([3]uint8, uint8)
+	var writeDst func(x, y int, c [3]uint8, a uint8)
+	switch img := dst.(type) {
+	case *image.RGBA:
+		readDst = straight8(img)
+		writeDst = func(x, y int, c [3]uint8, a uint8) {
+			i := img.PixOffset(x, y)
+			p := img.Pix[i : i+4 : i+4]
+			p[0] = premultiply8(c[0], a)
+			p[1] = premultiply8(c[1], a)
+			p[2] = premultiply8(c[2], a)
+			p[3] = a
+		}
+	case *image.NRGBA:
+		readDst = straight8(img)
+		writeDst = func(x, y int, c [3]uint8, a uint8) {
+			i := img.PixOffset(x, y)
+			p := img.Pix[i : i+4 : i+4]
+			p[0], p[1], p[2], p[3] = c[0], c[1], c[2], a
+		}
+	default:
+		d.drawSlow(dst, r, src, sp, mask, mp)
+		return
+	}
+
+	// Remember the last conversions; fills and flat regions repeat colors.
+	var lastSrc, lastDst [3]uint8
+	srcLatent := m.RGBToLatent(lastSrc)
+	dstLatent := srcLatent
+
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		sy := sp.Y + y - r.Min.Y
+		my := mp.Y + y - r.Min.Y
+		for x := r.Min.X; x < r.Max.X; x++ {
+			sx := sp.X + x - r.Min.X
+			mx := mp.X + x - r.Min.X
+
+			sc, sa8 := readSrc(sx, sy)
+			ma := readMask(mx, my)
+			if sa8 == 0 || ma == 0 {
+				continue
+			}
+			dc, da8 := readDst(x, y)
+
+			sa := float64(sa8) / 255 * float64(ma) / 255
+			da := float64(da8) / 255
+			outA := sa + da*(1-sa)
+			t := sa / outA
+
+			var c [3]uint8
+			switch {
+			case t >= 1:
+				c = sc
+			default:
+				if sc != lastSrc {
+					srcLatent, lastSrc = m.RGBToLatent(sc), sc
+				}
+				if dc != lastDst {
+					dstLatent, lastDst = m.RGBToLatent(dc), dc
+				}
+				c = dstLatent.Lerp(srcLatent, t).RGB()
+			}
+			writeDst(x, y, c, uint8(outA*255+0.5))
+		}
+	}
+}
+
+// drawSlow handles destinations without a fast path, keeping 16 bits of
+// precision per channel.
+func (d PigmentDrawer) drawSlow(dst draw.Image, r image.Rectangle, src image.Image, sp image.Point, mask image.Image, mp image.Point) {
+	m := d.mixer()
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		sy := sp.Y + y - r.Min.Y
+		my := mp.Y + y - r.Min.Y
+		for x := r.Min.X; x < r.Max.X; x++ {
+			sx := sp.X + x - r.Min.X
+			mx := mp.X + x - r.Min.X
+
+			sc, sa := straightFloat(src.At(sx, sy))
+			if mask != nil {
+				_, _, _, ma := mask.At(mx, my).RGBA()
+				sa *= float64(ma) / 0xffff
+			}
+			if sa == 0 {
+				continue
+			}
+			dc, da := straightFloat(dst.At(x, y))
+
+			outA := sa + da*(1-sa)
+			t := sa / outA
+			c := sc
+			if t < 1 {
+				c = m.LerpFloat(dc, sc, t)
+			}
+			dst.Set(x, y, color.NRGBA64{
+				R: uint16(c[0]*0xffff + 0.5),
+				G: uint16(c[1]*0xffff + 0.5),
+				B: uint16(c[2]*0xffff + 0.5),
+				A: uint16(outA*0xffff + 0.5),
+			})
+		}
+	}
+}
+
+// straightFloat returns c as straight-alpha sRGB and alpha in 0..1.
+func straightFloat(c color.Color) ([3]float64, float64) {
+	r, g, b, a := c.RGBA()
+	if a == 0 {
+		return [3]float64{}, 0
+	}
+	fa := float64(a)
+	return [3]float64{float64(r) / fa, float64(g) / fa, float64(b) / fa}, fa / 0xffff
+}
+
+// straight8 returns a reader for img's pixels as straight-alpha 8-bit
+// colors, avoiding color.Color boxing for common image types.
+func straight8(img image.Image) func(x, y int) ([3]uint8, uint8) {
+	switch img := img.(type) {
+	case *image.RGBA:
+		return func(x, y int) ([3]uint8, uint8) {
+			i := img.PixOffset(x, y)
+			p := img.Pix[i : i+4 : i+4]
+			return unpremultiply8(p[0], p[1], p[2], p[3])
+		}
+	case *image.NRGBA:
+		return func(x, y int) ([3]uint8, uint8) {
+			i := img.PixOffset(x, y)
+			p := img.Pix[i : i+4 : i+4]
+			return [3]uint8{p[0], p[1], p[2]}, p[3]
+		}
+	case *image.Uniform:
+		n := color.NRGBAModel.Convert(img.C).(color.NRGBA)
+		return func(x, y int) ([3]uint8, uint8) {
+			return [3]uint8{n.R, n.G, n.B}, n.A
+		}
+	}
+	return func(x, y int) ([3]uint8, uint8) {
+		n := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+		return [3]uint8{n.R, n.G, n.B}, n.A
+	}
+}
+
+// alpha8 returns a reader for the 8-bit alpha of mask; a nil mask is opaque.
+func alpha8(mask image.Image) func(x, y int) uint8 {
+	switch mask := mask.(type) {
+	case nil:
+		return func(x, y int) uint8 { return 0xff }
+	case *image.Alpha:
+		return func(x, y int) uint8 { return mask.Pix[mask.PixOffset(x, y)] }
+	case *image.Uniform:
+		_, _, _, a := mask.C.RGBA()
+		return func(x, y int) uint8 { return uint8(a >> 8) }
+	}
+	return func(x, y int) uint8 {
+		_, _, _, a := mask.At(x, y).RGBA()
+		return uint8(a >> 8)
+	}
+}
+
+func unpremultiply8(r, g, b, a uint8) ([3]uint8, uint8) {
+	switch a {
+	case 0:
+		return [3]uint8{}, 0
+	case 0xff:
+		return [3]uint8{r, g, b}, a
+	}
+	return [3]uint8{unpremultiplyChannel(r, a), unpremultiplyChannel(g, a), unpremultiplyChannel(b, a)}, a
+}
+
+// unpremultiplyChannel rounds like premultiply8, so a round trip through
+// both keeps the premultiplied value. Channels above a, which a valid
+// premultiplied color never has, saturate.
+func unpremultiplyChannel(c, a uint8) uint8 {
+	return uint8(min((uint32(c)*255+uint32(a)/2)/uint32(a), 255))
+}
+
+// clip clips r against each image's bounds (after translating into the
+// destination image's coordinate space) and shifts the points sp and mp by
+// the same amount as the change in r.Min, as image/draw does.
+func clip(dst draw.Image, r *image.Rectangle, src image.Image, sp *image.Point, mask image.Image, mp *image.Point) {
+	orig := r.Min
+	*r = r.Intersect(dst.Bounds())
+	*r = r.Intersect(src.Bounds().Add(orig.Sub(*sp)))
+	if mask != nil {
+		*r = r.Intersect(mask.Bounds().Add(orig.Sub(*mp)))
+	}
+	dx := r.Min.X - orig.X
+	dy := r.Min.Y - orig.Y
+	if dx == 0 && dy == 0 {
+		return
+	}
+	sp.X += dx
+	sp.Y += dy
+	mp.X += dx
+	mp.Y += dy
+}
@@ -0,0 +1,67 @@
+package mixbox
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+)
+
+// The RGBA fast path must give the NRGBA result premultiplied the way
+// LerpRGBA premultiplies, rounding rather than truncating.
+func TestPigmentOverRGBAMatchesNRGBA(t *testing.T) {
+	d := PigmentDrawer{Mixer: testMixer(t)}
+	// Destination colors with 0 and 255 channels survive premultiplication
+	// exactly, so both destinations start from the same straight colors.
+	dstColors := []color.NRGBA{{255, 0, 0, 128}, {0, 0, 255, 77}, {255, 255, 255, 255}, {0, 255, 0, 1}}
+	srcColors := []color.NRGBA{{254, 236, 0, 200}, {25, 0, 89, 33}, {123, 72, 0, 255}, {7, 109, 22, 129}}
+
+	r := image.Rect(0, 0, len(srcColors), len(dstColors))
+	src := image.NewNRGBA(r)
+	nrgba := image.NewNRGBA(r)
+	rgba := image.NewRGBA(r)
+	for y, dc := range dstColors {
+		for x, sc := range srcColors {
+			src.SetNRGBA(x, y, sc)
+			nrgba.SetNRGBA(x, y, dc)
+			rgba.Set(x, y, dc)
+		}
+	}
+	d.Draw(nrgba, r, src, image.Point{})
+	d.Draw(rgba, r, src, image.Point{})
+
+	for y := range dstColors {
+		for x := range srcColors {
+			n := nrgba.NRGBAAt(x, y)
+			want := color.RGBA{premultiply8(n.R, n.A), premultiply8(n.G, n.A), premultiply8(n.B, n.A), n.A}
+			if got := rgba.RGBAAt(x, y); got != want {
+				t.Errorf("pixel (%d, %d): RGBA %v, want %v (NRGBA %v)", x, y, got, want, n)
+			}
+		}
+	}
+}
+
+func TestPigmentOverOpaqueDestination(t *testing.T) {
+	m := testMixer(t)
+	d := PigmentDrawer{Mixer: m}
+	dc, sc := [3]uint8{254, 236, 0}, [3]uint8{25, 0, 89}
+	for _, a := range []uint8{0, 1, 64, 128, 200, 254, 255} {
+		dst := image.NewRGBA(image.Rect(0, 0, 1, 1))
+		dst.SetRGBA(0, 0, color.RGBA{dc[0], dc[1], dc[2], 255})
+		src := image.NewUniform(color.NRGBA{sc[0], sc[1], sc[2], a})
+		d.Draw(dst, dst.Bounds(), src, image.Point{})
+
+		want := m.Lerp(dc, sc, float64(a)/255)
+		got := dst.RGBAAt(0, 0)
+		if [3]uint8{got.R, got.G, got.B} != want || got.A != 255 {
+			t.Errorf("alpha %d: got %v, want %v", a, got, want)
+		}
+	}
+}
+
+// PigmentOver is a concrete PigmentDrawer, so DrawMask is reachable
+// without a type assertion.
+var _ interface {
+	draw.Drawer
+	DrawMask(draw.Image, image.Rectangle, image.Image, image.Point, image.Image, image.Point)
+} = PigmentOver
@@ -0,0 +1,335 @@
+package mixbox
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// The fixed-point pipeline mixes 8-bit colors without floating point, for
+// targets where it is slow or unavailable. Latent channels are Q24 numbers
+// (one is 1<<24) held in int32; products are formed in int64 and shifted
+// back. Results stay within one 8-bit step of Lerp; VerifyFixed measures
+// the exact agreement.
+
+const (
+	fixedShift = 24
+	fixedOne   = 1 << fixedShift
+)
+
+// FixedLatent is a latent in Q24 fixed point.
+type FixedLatent [LatentSize]int32
+
+// fixedCoefficients are the coefficients of evalPolynomial in Q24, in the
+// same term order.
+var fixedCoefficients = [20][3]int64{
+	{1294707, 474288, 4166285},      // c0 * c00
+	{16091414, 13464811, 597577},    // c1 * c11
+	{12529858, 816813, 0},           // c2 * c22
+	{16696373, 16773550, 16727690},  // c3 * c33
+	{808519, 13986122, 5455175},     // c00 * c1
+	{-11433161, 24512822, 17948423}, // c01 * c1
+	{4539649, -2571087, 33342210},   // c00 * c2
+	{13502000, 11256457, 3091118},   // c02 * c2
+	{-5877227, 23128370, 61885278},  // c00 * c3
+	{17637559, 33187890, 47477688},  // c0 * c33
+	{53956722, 13634882, 17345047},  // c11 * c2
+	{46790544, 6973542, -752843},    // c1 * c22
+	{50694468, 42844665, 5497242},   // c11 * c3
+	{49513707, 47177718, 19726389},  // c1 * c33
+	{47425338, 13410538, 30486762},  // c22 * c3
+	{50279823, 20567701, 30308655},  // c2 * c33
+	{31439514, 34397853, -5005649},  // c01 * c2
+	{43051941, 118015668, 10498406}, // c01 * c3
+	{68506320, -23556614, 36070263}, // c02 * c3
+	{100676496, 42874518, 32000778}, // c12 * c3
+}
+
+func fixedMul(a, b int64) int64 {
+	return a * b >> fixedShift
+}
+
+// fixedPolynomial is evalPolynomial in Q24.
+func fixedPolynomial(c0, c1, c2, c3 int64) [3]int64 {
+	c00 := fixedMul(c0, c0)
+	c11 := fixedMul(c1, c1)
+	c22 := fixedMul(c2, c2)
+	c33 := fixedMul(c3, c3)
+	c01 := fixedMul(c0, c1)
+	c02 := fixedMul(c0, c2)
+	c12 := fixedMul(c1, c2)
+
+	terms := [20]int64{
+		fixedMul(c0, c00),
+		fixedMul(c1, c11),
+		fixedMul(c2, c22),
+		fixedMul(c3, c33),
+		fixedMul(c00, c1),
+		fixedMul(c01, c1),
+		fixedMul(c00, c2),
+		fixedMul(c02, c2),
+		fixedMul(c00, c3),
+		fixedMul(c0, c33),
+		fixedMul(c11, c2),
+		fixedMul(c1, c22),
+		fixedMul(c11, c3),
+		fixedMul(c1, c33),
+		fixedMul(c22, c3),
+		fixedMul(c2, c33),
+		fixedMul(c01, c2),
+		fixedMul(c01, c3),
+		fixedMul(c02, c3),
+		fixedMul(c12, c3),
+	}
+
+	// Accumulate the full products and shift once to avoid 20 truncations.
+	var acc [3]int64
+	for i, w := range terms {
+		acc[0] += fixedCoefficients[i][0] * w
+		acc[1] += fixedCoefficients[i][1] * w
+		acc[2] += fixedCoefficients[i][2] * w
+	}
+	return [3]int64{acc[0] >> fixedShift, acc[1] >> fixedShift, acc[2] >> fixedShift}
+}
+
+// RGBToFixedLatent calls Mixer.RGBToFixedLatent on the default Mixer.
+func RGBToFixedLatent(rgb [3]uint8) FixedLatent {
+	return Default().RGBToFixedLatent(rgb)
+}
+
+// RGBToFixedLatent is RGBToLatent in integer arithmetic. It always reads
+// the lookup table, even if the Mixer has a LatentCache.
+func (m *Mixer) RGBToFixedLatent(rgb [3]uint8) FixedLatent {
+	var cell [3]int
+	var frac [3]int64
+	for i, v := range rgb {
+		// v/255*63 in Q24, split into the cell index and the position in it.
+		x := int64(v) * 63 * fixedOne / 255
+		cell[i] = int(x >> fixedShift)
+		frac[i] = x & (fixedOne - 1)
+	}
+	tx, ty, tz := frac[0], frac[1], frac[2]
+	sx, sy, sz := fixedOne-tx, fixedOne-ty, fixedOne-tz
+
+	xyz := (cell[0] + cell[1]*64 + cell[2]*64*64) & 0x3FFFF
+
+	weights := [8]int64{
+		fixedMul(fixedMul(sx, sy), sz),
+		fixedMul(fixedMul(tx, sy), sz),
+		fixedMul(fixedMul(sx, ty), sz),
+		fixedMul(fixedMul(tx, ty), sz),
+		fixedMul(fixedMul(sx, sy), tz),
+		fixedMul(fixedMul(tx, sy), tz),
+		fixedMul(fixedMul(sx, ty), tz),
+		fixedMul(fixedMul(tx, ty), tz),
+	}
+
+	var c0, c1, c2 int64
+	lut := m.lut
+	for i, offset := range cornerOffsets {
+		w := weights[i]
+		c0 += w * int64(lut[xyz+offset])
+		c1 += w * int64(lut[xyz+offset+262144])
+		c2 += w * int64(lut[xyz+offset+524288])
+	}
+
+	c0 = (c0 + 127) / 255
+	c1 = (c1 + 127) / 255
+	c2 = (c2 + 127) / 255
+	c3 := fixedOne - (c0 + c1 + c2)
+
+	mix := fixedPolynomial(c0, c1, c2, c3)
+
+	var latent FixedLatent
+	latent[0], latent[1], latent[2], latent[3] = int32(c0), int32(c1), int32(c2), int32(c3)
+	for i, v := range rgb {
+		latent[4+i] = int32((int64(v)*fixedOne+127)/255 - mix[i])
+	}
+	return latent
+}
+
+// Lerp mixes l and o as Latent.Lerp does. t is a 16-bit fraction: 0 gives
+// l and 0xffff gives o.
+func (l FixedLatent) Lerp(o FixedLatent, t uint16) FixedLatent {
+	// Map 0..0xffff onto 0..1<<16 so that both ends are exact.
+	q := int64(t) + int64(t>>15)
+	var mixed FixedLatent
+	for i := range mixed {
+		a, b := int64(l[i]), int64(o[i])
+		mixed[i] = int32(a + (b-a)*q>>16)
+	}
+	return mixed
+}
+
+// RGB decodes l to 8-bit sRGB, rounding like LatentToRGB.
+func (l FixedLatent) RGB() [3]uint8 {
+	mix := fixedPolynomial(int64(l[0]), int64(l[1]), int64(l[2]), int64(l[3]))
+	var rgb [3]uint8
+	for i := range rgb {
+		v := min(max(mix[i]+int64(l[4+i]), 0), fixedOne)
+		rgb[i] = uint8((v*255 + fixedOne/2) >> fixedShift)
+	}
+	return rgb
+}
+
+// Latent converts l to a floating-point Latent.
+func (l FixedLatent) Latent() Latent {
+	var latent Latent
+	for i, v := range l {
+		latent[i] = float64(v) / fixedOne
+	}
+	return latent
+}
+
+// FixedRatio converts a mixing ratio in 0..1 to the 16-bit fraction taken
+// by the fixed-point functions. Values outside 0..1 are clamped.
+func FixedRatio(t float64) uint16 {
+	return uint16(clamp01(t)*0xffff + 0.5)
+}
+
+// LerpFixed calls Mixer.LerpFixed on the default Mixer.
+func LerpFixed(rgb1, rgb2 [3]uint8, t uint16) [3]uint8 {
+	return Default().LerpFixed(rgb1, rgb2, t)
+}
+
+// LerpFixed is Lerp computed entirely in integer arithmetic. t is a 16-bit
+// fraction, see FixedRatio.
+func (m *Mixer) LerpFixed(rgb1, rgb2 [3]uint8, t uint16) [3]uint8 {
+	return m.RGBToFixedLatent(rgb1).Lerp(m.RGBToFixedLatent(rgb2), t).RGB()
+}
+
+// LerpSlicesFixed calls Mixer.LerpSlicesFixed on the default Mixer.
+func LerpSlicesFixed(dst, a, b []uint8, t uint16) int {
+	return Default().LerpSlicesFixed(dst, a, b, t)
+}
+
+// LerpRampFixed calls Mixer.LerpRampFixed on the default Mixer.
+func LerpRampFixed(dst []uint8, rgb1, rgb2 [3]uint8) int {
+	return Default().LerpRampFixed(dst, rgb1, rgb2)
+}
+
+// LerpSlicesFixed is LerpSlices in integer arithmetic.
+func (m *Mixer) LerpSlicesFixed(dst, a, b []uint8, t uint16) int {
+	n := min(len(dst), len(a), len(b)) / 3
+	var prevA, prevB [3]uint8
+	var latentA, latentB FixedLatent
+	for i := 0; i < n; i++ {
+		rgbA := [3]uint8{a[3*i], a[3*i+1], a[3*i+2]}
+		rgbB := [3]uint8{b[3*i], b[3*i+1], b[3*i+2]}
+		if i == 0 || rgbA != prevA {
+			latentA = m.RGBToFixedLatent(rgbA)
+			prevA = rgbA
+		}
+		if i == 0 || rgbB != prevB {
+			latentB = m.RGBToFixedLatent(rgbB)
+			prevB = rgbB
+		}
+		rgb := latentA.Lerp(latentB, t).RGB()
+		dst[3*i], dst[3*i+1], dst[3*i+2] = rgb[0], rgb[1], rgb[2]
+	}
+	return n
+}
+
+// LerpRampFixed is LerpRamp in integer arithmetic.
+func (m *Mixer) LerpRampFixed(dst []uint8, rgb1, rgb2 [3]uint8) int {
+	n := len(dst) / 3
+	latent1 := m.RGBToFixedLatent(rgb1)
+	latent2 := m.RGBToFixedLatent(rgb2)
+	for i := 0; i < n; i++ {
+		var t uint16
+		if n > 1 {
+			t = uint16((i*0xffff + (n-1)/2) / (n - 1))
+		}
+		rgb := latent1.Lerp(latent2, t).RGB()
+		dst[3*i], dst[3*i+1], dst[3*i+2] = rgb[0], rgb[1], rgb[2]
+	}
+	return n
+}
+
+// FixedReport summarizes how LerpFixed compares with Lerp.
+type FixedReport struct {
+	Mixes int
+	// Mismatches counts mixes where any channel differs; MaxDeviation is
+	// the largest difference in 8-bit steps.
+	Mismatches   int
+	MaxDeviation int
+	// Worst is the first mix with the largest difference.
+	Worst struct {
+		RGB1, RGB2 [3]uint8
+		T          uint16
+	}
+}
+
+// WithinOne reports whether no channel differed by more than one 8-bit step.
+func (r FixedReport) WithinOne() bool {
+	return r.MaxDeviation <= 1
+}
+
+func (r FixedReport) String() string {
+	return fmt.Sprintf("%d mixes: %d differ from Lerp, max %d", r.Mixes, r.Mismatches, r.MaxDeviation)
+}
+
+// FixedRatios are the mixing ratios VerifyFixed checks each pair at.
+var FixedRatios = []uint16{0, 0x1999, 0x4000, 0x7fff, 0x8000, 0xc000, 0xe666, 0xffff}
+
+// VerifyFixed compares LerpFixed with Lerp on m for pairs random 8-bit
+// color pairs drawn from seed, plus every pair of the colors whose
+// channels are multiples of 51, each at all FixedRatios. Lerp is evaluated
+// without m's LatentCache so the comparison is against the exact path.
+func VerifyFixed(m *Mixer, pairs int, seed int64) FixedReport {
+	exact := *m
+	exact.cache = nil
+
+	var report FixedReport
+	check := func(a, b [3]uint8) {
+		la, lb := exact.RGBToLatent(a), exact.RGBToLatent(b)
+		fa, fb := exact.RGBToFixedLatent(a), exact.RGBToFixedLatent(b)
+		for _, t := range FixedRatios {
+			want := la.Lerp(lb, float64(t)/0xffff).RGB()
+			got := fa.Lerp(fb, t).RGB()
+			report.Mixes++
+			d := 0
+			for i := range got {
+				d = max(d, absInt(int(got[i])-int(want[i])))
+			}
+			if d == 0 {
+				continue
+			}
+			report.Mismatches++
+			if d > report.MaxDeviation {
+				report.MaxDeviation = d
+				report.Worst.RGB1, report.Worst.RGB2, report.Worst.T = a, b, t
+			}
+		}
+	}
+
+	var grid [][3]uint8
+	for r := 0; r < 256; r += 51 {
+		for g := 0; g < 256; g += 51 {
+			for b := 0; b < 256; b += 51 {
+				grid = append(grid, [3]uint8{uint8(r), uint8(g), uint8(b)})
+			}
+		}
+	}
+	for _, a := range grid {
+		for _, b := range grid {
+			check(a, b)
+		}
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	random := func() [3]uint8 {
+		v := rng.Uint32()
+		return [3]uint8{uint8(v), uint8(v >> 8), uint8(v >> 16)}
+	}
+	for i := 0; i < pairs; i++ {
+		check(random(), random())
+	}
+	return report
+}
+
+func absInt(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
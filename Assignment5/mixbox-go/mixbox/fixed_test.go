@@ -0,0 +1,97 @@
+package mixbox
+
+import "testing"
+
+func TestVerifyFixed(t *testing.T) {
+	embeddedTable(t)
+	pairs := 100000
+	if testing.Short() {
+		pairs = 1000
+	}
+	for _, seed := range []int64{1, 2} {
+		report := VerifyFixed(Default(), pairs, seed)
+		if want := (216*216 + pairs) * len(FixedRatios); report.Mixes != want {
+			t.Errorf("seed %d: %d mixes checked, want %d", seed, report.Mixes, want)
+		}
+		if report.MaxDeviation > 1 || !report.WithinOne() {
+			w := report.Worst
+			t.Errorf("seed %d: %v; worst: LerpFixed(%v, %v, %#x)", seed, report, w.RGB1, w.RGB2, w.T)
+		}
+	}
+}
+
+// LerpFixed is checked against Lerp directly as well, so a mistake in
+// VerifyFixed's bookkeeping cannot hide one in the fixed-point path.
+func TestLerpFixedWithinOneOfLerp(t *testing.T) {
+	m := testMixer(t)
+	for _, c := range randomMixCases(2000, 3) {
+		for _, ft := range FixedRatios {
+			got := m.LerpFixed(c.rgb1, c.rgb2, ft)
+			want := m.Lerp(c.rgb1, c.rgb2, float64(ft)/0xffff)
+			for i := range got {
+				if d := int(got[i]) - int(want[i]); d < -1 || d > 1 {
+					t.Fatalf("LerpFixed(%v, %v, %#x) = %v, Lerp gives %v", c.rgb1, c.rgb2, ft, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestFixedRatio(t *testing.T) {
+	for _, tt := range []struct {
+		t    float64
+		want uint16
+	}{{-1, 0}, {0, 0}, {0.5, 0x8000}, {1, 0xffff}, {2, 0xffff}} {
+		if got := FixedRatio(tt.t); got != tt.want {
+			t.Errorf("FixedRatio(%v) = %#x, want %#x", tt.t, got, tt.want)
+		}
+	}
+}
+
+func TestFixedBatchMatchesLerpFixed(t *testing.T) {
+	m := testMixer(t)
+	const n = 1000
+	a, b := photoPixels(n)
+	pixel := func(s []uint8, i int) [3]uint8 { return [3]uint8{s[3*i], s[3*i+1], s[3*i+2]} }
+	dst := make([]uint8, 3*n)
+
+	for _, ft := range FixedRatios {
+		if got := m.LerpSlicesFixed(dst, a, b, ft); got != n {
+			t.Fatalf("LerpSlicesFixed = %d, want %d", got, n)
+		}
+		for i := 0; i < n; i++ {
+			if got, want := pixel(dst, i), m.LerpFixed(pixel(a, i), pixel(b, i), ft); got != want {
+				t.Fatalf("LerpSlicesFixed(t=%#x) pixel %d = %v, want %v", ft, i, got, want)
+			}
+		}
+	}
+
+	c1, c2 := [3]uint8{254, 236, 0}, [3]uint8{25, 0, 89}
+	if got := m.LerpRampFixed(dst, c1, c2); got != n {
+		t.Fatalf("LerpRampFixed = %d, want %d", got, n)
+	}
+	for i := 0; i < n; i++ {
+		want := m.LerpFixed(c1, c2, FixedRatio(float64(i)/(n-1)))
+		if got := pixel(dst, i); got != want {
+			t.Fatalf("LerpRampFixed pixel %d = %v, want %v", i, got, want)
+		}
+	}
+
+	if allocs := testing.AllocsPerRun(10, func() { m.LerpSlicesFixed(dst, a, b, 0x8000) }); allocs != 0 {
+		t.Errorf("LerpSlicesFixed allocates %v times per call, want 0", allocs)
+	}
+	if allocs := testing.AllocsPerRun(10, func() { m.LerpRampFixed(dst, c1, c2) }); allocs != 0 {
+		t.Errorf("LerpRampFixed allocates %v times per call, want 0", allocs)
+	}
+}
+
+func BenchmarkLerpSlicesFixed(b *testing.B) {
+	m := testMixer(b)
+	src, other := photoPixels(benchPixels)
+	dst := make([]uint8, 3*benchPixels)
+	b.SetBytes(int64(len(src)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		m.LerpSlicesFixed(dst, src, other, 0x8000)
+	}
+}
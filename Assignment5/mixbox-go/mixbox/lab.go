@@ -0,0 +1,207 @@
+package mixbox
+
+import "math"
+
+// Lab is a CIELAB color relative to the D50 white point, as used by ICC
+// profiles and CSS lab(). L runs from 0 to 100.
+type Lab struct {
+	L, A, B float64
+}
+
+// OKLab is a color in Björn Ottosson's OKLab space. L runs from 0 to 1.
+type OKLab struct {
+	L, A, B float64
+}
+
+// D50 is the white point of CIELAB.
+var D50 = [2]float64{0.3457, 0.3585}
+
+var (
+	srgbToXYZD50, xyzD50ToSRGB = func() (mat3, mat3) {
+		toXYZ, _ := rgbToXYZ(srgbPrimaries)
+		m := bradford(D65, D50).mul(toXYZ)
+		inv, _ := m.inverse()
+		return m, inv
+	}()
+	d50White = xyToXYZ(D50)
+)
+
+const (
+	labEpsilon = 216.0 / 24389
+	labKappa   = 24389.0 / 27
+)
+
+// The conversions below accept and return sRGB values outside 0..1, so
+// colors outside the sRGB gamut survive a round trip. As in CSS, the sRGB
+// transfer function is extended to negative values by symmetry.
+
+func signedToLinear(x float64) float64 {
+	return math.Copysign(srgbToLinear(math.Abs(x)), x)
+}
+
+func signedFromLinear(x float64) float64 {
+	return math.Copysign(linearToSrgb(math.Abs(x)), x)
+}
+
+// FloatRGBToLab converts sRGB with channels in 0..1 to CIELAB.
+func FloatRGBToLab(rgb [3]float64) Lab {
+	xyz := srgbToXYZD50.apply([3]float64{signedToLinear(rgb[0]), signedToLinear(rgb[1]), signedToLinear(rgb[2])})
+	f := func(t float64) float64 {
+		if t > labEpsilon {
+			return math.Cbrt(t)
+		}
+		return (labKappa*t + 16) / 116
+	}
+	fx, fy, fz := f(xyz[0]/d50White[0]), f(xyz[1]), f(xyz[2]/d50White[2])
+	return Lab{116*fy - 16, 500 * (fx - fy), 200 * (fy - fz)}
+}
+
+// LabToFloatRGB converts CIELAB to sRGB. The result is not clamped.
+func LabToFloatRGB(lab Lab) [3]float64 {
+	fy := (lab.L + 16) / 116
+	fx := lab.A/500 + fy
+	fz := fy - lab.B/200
+	inv := func(f float64) float64 {
+		if f3 := f * f * f; f3 > labEpsilon {
+			return f3
+		}
+		return (116*f - 16) / labKappa
+	}
+	y := lab.L / labKappa
+	if lab.L > labKappa*labEpsilon {
+		y = fy * fy * fy
+	}
+	lin := xyzD50ToSRGB.apply([3]float64{inv(fx) * d50White[0], y, inv(fz) * d50White[2]})
+	return [3]float64{signedFromLinear(lin[0]), signedFromLinear(lin[1]), signedFromLinear(lin[2])}
+}
+
+// RGBToLab converts an 8-bit sRGB color to CIELAB.
+func RGBToLab(rgb [3]uint8) Lab {
+	return FloatRGBToLab([3]float64{float64(rgb[0]) / 255, float64(rgb[1]) / 255, float64(rgb[2]) / 255})
+}
+
+// FloatRGBToOKLab converts sRGB with channels in 0..1 to OKLab.
+func FloatRGBToOKLab(rgb [3]float64) OKLab {
+	r, g, b := signedToLinear(rgb[0]), signedToLinear(rgb[1]), signedToLinear(rgb[2])
+	l := math.Cbrt(0.4122214708*r + 0.5363325363*g + 0.0514459929*b)
+	m := math.Cbrt(0.2119034982*r + 0.6806995451*g + 0.1073969566*b)
+	s := math.Cbrt(0.0883024619*r + 0.2817188376*g + 0.6299787005*b)
+	return OKLab{
+		0.2104542553*l + 0.7936177850*m - 0.0040720468*s,
+		1.9779984951*l - 2.4285922050*m + 0.4505937099*s,
+		0.0259040371*l + 0.7827717662*m - 0.8086757660*s,
+	}
+}
+
+// OKLabToFloatRGB converts OKLab to sRGB. The result is not clamped.
+func OKLabToFloatRGB(c OKLab) [3]float64 {
+	l := c.L + 0.3963377774*c.A + 0.2158037573*c.B
+	m := c.L - 0.1055613458*c.A - 0.0638541728*c.B
+	s := c.L - 0.0894841775*c.A - 1.2914855480*c.B
+	l, m, s = l*l*l, m*m*m, s*s*s
+	return [3]float64{
+		signedFromLinear(4.0767416621*l - 3.3077115913*m + 0.2309699292*s),
+		signedFromLinear(-1.2684380046*l + 2.6097574011*m - 0.3413193965*s),
+		signedFromLinear(-0.0041960863*l - 0.7034186147*m + 1.7076147010*s),
+	}
+}
+
+// RGBToOKLab converts an 8-bit sRGB color to OKLab.
+func RGBToOKLab(rgb [3]uint8) OKLab {
+	return FloatRGBToOKLab([3]float64{float64(rgb[0]) / 255, float64(rgb[1]) / 255, float64(rgb[2]) / 255})
+}
+
+// DeltaE76 is the CIE 1976 color difference, the Euclidean distance in
+// CIELAB. A difference of about 2.3 is just noticeable.
+func DeltaE76(a, b Lab) float64 {
+	return math.Sqrt(sq(a.L-b.L) + sq(a.A-b.A) + sq(a.B-b.B))
+}
+
+// DeltaE94 is the CIE 1994 color difference with the graphic arts
+// weights. It is not symmetric: a is the reference.
+func DeltaE94(a, b Lab) float64 {
+	const kL, k1, k2 = 1, 0.045, 0.015
+	c1 := math.Hypot(a.A, a.B)
+	c2 := math.Hypot(b.A, b.B)
+	dL := a.L - b.L
+	dC := c1 - c2
+	dH2 := math.Max(sq(a.A-b.A)+sq(a.B-b.B)-sq(dC), 0)
+	sC := 1 + k1*c1
+	sH := 1 + k2*c1
+	return math.Sqrt(sq(dL/kL) + sq(dC/sC) + dH2/sq(sH))
+}
+
+// DeltaE2000 is the CIEDE2000 color difference, the most perceptually
+// uniform of the three.
+func DeltaE2000(a, b Lab) float64 {
+	const deg = math.Pi / 180
+	const pow25to7 = 6103515625 // 25^7
+
+	c1 := math.Hypot(a.A, a.B)
+	c2 := math.Hypot(b.A, b.B)
+	cMean7 := math.Pow((c1+c2)/2, 7)
+	g := 0.5 * (1 - math.Sqrt(cMean7/(cMean7+pow25to7)))
+	a1, a2 := (1+g)*a.A, (1+g)*b.A
+	c1p, c2p := math.Hypot(a1, a.B), math.Hypot(a2, b.B)
+	hue := func(b, a float64) float64 {
+		if a == 0 && b == 0 {
+			return 0
+		}
+		h := math.Atan2(b, a) / deg
+		if h < 0 {
+			h += 360
+		}
+		return h
+	}
+	h1p, h2p := hue(a.B, a1), hue(b.B, a2)
+	// Hues that are opposite up to rounding count as exactly 180° apart,
+	// as they do in Sharma's test data.
+	const hueSlop = 1e-9
+
+	dLp := b.L - a.L
+	dCp := c2p - c1p
+	var dhp float64
+	if c1p*c2p != 0 {
+		dhp = h2p - h1p
+		switch {
+		case dhp > 180+hueSlop:
+			dhp -= 360
+		case dhp < -180-hueSlop:
+			dhp += 360
+		}
+	}
+	dHp := 2 * math.Sqrt(c1p*c2p) * math.Sin(dhp/2*deg)
+
+	lMean := (a.L + b.L) / 2
+	cMeanP := (c1p + c2p) / 2
+	hMeanP := h1p + h2p
+	if c1p*c2p != 0 {
+		switch {
+		case math.Abs(h1p-h2p) <= 180+hueSlop:
+			hMeanP /= 2
+		case h1p+h2p < 360:
+			hMeanP = (hMeanP + 360) / 2
+		default:
+			hMeanP = (hMeanP - 360) / 2
+		}
+	}
+	t := 1 - 0.17*math.Cos((hMeanP-30)*deg) + 0.24*math.Cos(2*hMeanP*deg) +
+		0.32*math.Cos((3*hMeanP+6)*deg) - 0.20*math.Cos((4*hMeanP-63)*deg)
+	dTheta := 30 * math.Exp(-sq((hMeanP-275)/25))
+	cMeanP7 := math.Pow(cMeanP, 7)
+	rC := 2 * math.Sqrt(cMeanP7/(cMeanP7+pow25to7))
+	sL := 1 + 0.015*sq(lMean-50)/math.Sqrt(20+sq(lMean-50))
+	sC := 1 + 0.045*cMeanP
+	sH := 1 + 0.015*cMeanP*t
+	rT := -math.Sin(2*dTheta*deg) * rC
+
+	return math.Sqrt(sq(dLp/sL) + sq(dCp/sC) + sq(dHp/sH) + rT*(dCp/sC)*(dHp/sH))
+}
+
+// DeltaEOK is the Euclidean distance in OKLab. A difference of about 0.02
+// is just noticeable.
+func DeltaEOK(a, b OKLab) float64 {
+	return math.Sqrt(sq(a.L-b.L) + sq(a.A-b.A) + sq(a.B-b.B))
+}
+
+func sq(x float64) float64 { return x * x }
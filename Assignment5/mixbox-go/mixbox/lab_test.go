@@ -0,0 +1,113 @@
+package mixbox
+
+import (
+	"math"
+	"testing"
+)
+
+// sharmaPairs are the CIEDE2000 test data of Sharma, Wu and Dalal, "The
+// CIEDE2000 Color-Difference Formula: Implementation Notes, Supplementary
+// Test Data, and Mathematical Observations" (2005), table 1.
+var sharmaPairs = []struct {
+	a, b Lab
+	de   float64
+}{
+	{Lab{50, 2.6772, -79.7751}, Lab{50, 0, -82.7485}, 2.0425},
+	{Lab{50, 3.1571, -77.2803}, Lab{50, 0, -82.7485}, 2.8615},
+	{Lab{50, 2.8361, -74.0200}, Lab{50, 0, -82.7485}, 3.4412},
+	{Lab{50, -1.3802, -84.2814}, Lab{50, 0, -82.7485}, 1.0000},
+	{Lab{50, -1.1848, -84.8006}, Lab{50, 0, -82.7485}, 1.0000},
+	{Lab{50, -0.9009, -85.5211}, Lab{50, 0, -82.7485}, 1.0000},
+	{Lab{50, 0, 0}, Lab{50, -1, 2}, 2.3669},
+	{Lab{50, -1, 2}, Lab{50, 0, 0}, 2.3669},
+	{Lab{50, 2.4900, -0.0010}, Lab{50, -2.4900, 0.0009}, 7.1792},
+	{Lab{50, 2.4900, -0.0010}, Lab{50, -2.4900, 0.0010}, 7.1792},
+	{Lab{50, 2.4900, -0.0010}, Lab{50, -2.4900, 0.0011}, 7.2195},
+	{Lab{50, 2.4900, -0.0010}, Lab{50, -2.4900, 0.0012}, 7.2195},
+	{Lab{50, -0.0010, 2.4900}, Lab{50, 0.0009, -2.4900}, 4.8045},
+	{Lab{50, -0.0010, 2.4900}, Lab{50, 0.0010, -2.4900}, 4.8045},
+	{Lab{50, -0.0010, 2.4900}, Lab{50, 0.0011, -2.4900}, 4.7461},
+	{Lab{50, 2.5, 0}, Lab{50, 0, -2.5}, 4.3065},
+	{Lab{50, 2.5, 0}, Lab{73, 25, -18}, 27.1492},
+	{Lab{50, 2.5, 0}, Lab{61, -5, 29}, 22.8977},
+	{Lab{50, 2.5, 0}, Lab{56, -27, -3}, 31.9030},
+	{Lab{50, 2.5, 0}, Lab{58, 24, 15}, 19.4535},
+	{Lab{50, 2.5, 0}, Lab{50, 3.1736, 0.5854}, 1.0000},
+	{Lab{50, 2.5, 0}, Lab{50, 3.2972, 0}, 1.0000},
+	{Lab{50, 2.5, 0}, Lab{50, 1.8634, 0.5757}, 1.0000},
+	{Lab{50, 2.5, 0}, Lab{50, 3.2592, 0.3350}, 1.0000},
+	{Lab{60.2574, -34.0099, 36.2677}, Lab{60.4626, -34.1751, 39.4387}, 1.2644},
+	{Lab{63.0109, -31.0961, -5.8663}, Lab{62.8187, -29.7946, -4.0864}, 1.2630},
+	{Lab{61.2901, 3.7196, -5.3901}, Lab{61.4292, 2.2480, -4.9620}, 1.8731},
+	{Lab{35.0831, -44.1164, 3.7933}, Lab{35.0232, -40.0716, 1.5901}, 1.8645},
+	{Lab{22.7233, 20.0904, -46.6940}, Lab{23.0331, 14.9730, -42.5619}, 2.0373},
+	{Lab{36.4612, 47.8580, 18.3852}, Lab{36.2715, 50.5065, 21.2231}, 1.4146},
+	{Lab{90.8027, -2.0831, 1.4410}, Lab{91.1528, -1.6435, 0.0447}, 1.4441},
+	{Lab{90.9257, -0.5406, -0.9208}, Lab{88.6381, -0.8985, -0.7239}, 1.5381},
+	{Lab{6.7747, -0.2908, -2.4247}, Lab{5.8714, -0.0985, -2.2286}, 0.6377},
+	{Lab{2.0776, 0.0795, -1.1350}, Lab{0.9033, -0.0636, -0.5514}, 0.9082},
+}
+
+func TestDeltaE2000(t *testing.T) {
+	for i, p := range sharmaPairs {
+		// The published differences are rounded to four decimals.
+		if got := DeltaE2000(p.a, p.b); math.Abs(got-p.de) > 5e-5 {
+			t.Errorf("pair %d: DeltaE2000(%v, %v) = %.4f, want %.4f", i+1, p.a, p.b, got, p.de)
+		}
+		if got, back := DeltaE2000(p.a, p.b), DeltaE2000(p.b, p.a); math.Abs(got-back) > 1e-9 {
+			t.Errorf("pair %d: DeltaE2000 is not symmetric: %v and %v", i+1, got, back)
+		}
+	}
+}
+
+func TestDeltaE76(t *testing.T) {
+	for _, tt := range []struct {
+		a, b Lab
+		want float64
+	}{
+		{Lab{50, 0, 0}, Lab{50, 0, 0}, 0},
+		{Lab{50, 0, 0}, Lab{60, 0, 0}, 10},
+		{Lab{50, 3, 0}, Lab{50, 0, 4}, 5},
+		{Lab{0, -10, 10}, Lab{100, 10, -10}, math.Sqrt(10800)},
+	} {
+		if got := DeltaE76(tt.a, tt.b); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("DeltaE76(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestDeltaE94(t *testing.T) {
+	for _, tt := range []struct {
+		a, b Lab
+		want float64
+	}{
+		{Lab{50, 0, 0}, Lab{50, 0, 0}, 0},
+		// Lightness is not weighted.
+		{Lab{50, 0, 0}, Lab{60, 0, 0}, 10},
+		// Chroma is weighted by 1 + 0.045 C of the reference, so the
+		// difference is not symmetric.
+		{Lab{50, 3, 4}, Lab{50, 0, 0}, 5 / 1.225},
+		{Lab{50, 0, 0}, Lab{50, 3, 4}, 5},
+		// A pure hue difference is weighted by 1 + 0.015 C.
+		{Lab{50, 5, 0}, Lab{50, 0, 5}, math.Sqrt(50) / 1.075},
+	} {
+		if got := DeltaE94(tt.a, tt.b); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("DeltaE94(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+// DeltaE94 only ever divides the DeltaE76 terms by weights of at least
+// one, and all three metrics are zero exactly for identical colors.
+func TestDeltaEOrdering(t *testing.T) {
+	for _, c := range randomMixCases(200, 5) {
+		a, b := RGBToLab(c.rgb1), RGBToLab(c.rgb2)
+		de76, de94, de2000 := DeltaE76(a, b), DeltaE94(a, b), DeltaE2000(a, b)
+		if de94 > de76+1e-9 {
+			t.Errorf("%v, %v: DeltaE94 %v exceeds DeltaE76 %v", c.rgb1, c.rgb2, de94, de76)
+		}
+		if (de76 == 0) != (de2000 == 0) {
+			t.Errorf("%v, %v: DeltaE76 %v but DeltaE2000 %v", c.rgb1, c.rgb2, de76, de2000)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package mixbox
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Latent is the pigment representation colors are mixed in. Mixing in
+// latent space is linear: a weighted sum of latents with weights adding up
+// to one is the latent of the mixed paint.
+type Latent [LatentSize]float64
+
+// Add returns the component-wise sum of l and o.
+func (l Latent) Add(o Latent) Latent {
+	for i := range l {
+		l[i] += o[i]
+	}
+	return l
+}
+
+// Scale returns l with every component multiplied by f.
+func (l Latent) Scale(f float64) Latent {
+	for i := range l {
+		l[i] *= f
+	}
+	return l
+}
+
+// Lerp returns the mix of (1-t) parts of l and t parts of o.
+func (l Latent) Lerp(o Latent, t float64) Latent {
+	for i := range l {
+		l[i] = (1.0-t)*l[i] + t*o[i]
+	}
+	return l
+}
+
+// RGB converts l to an 8-bit sRGB color.
+func (l Latent) RGB() [3]uint8 {
+	return LatentToRGB(l)
+}
+
+// FloatRGB converts l to an sRGB color with channels in 0..1.
+func (l Latent) FloatRGB() [3]float64 {
+	return LatentToFloatRGB(l)
+}
+
+// LinearFloatRGB converts l to a linear-light RGB color with channels in 0..1.
+func (l Latent) LinearFloatRGB() [3]float64 {
+	return LatentToLinearFloatRGB(l)
+}
+
+// Color is a color that can be taken into latent space for mixing. RGB,
+// FloatRGB and LinearFloatRGB wrap the three input encodings the package
+// understands.
+type Color interface {
+	ToLatent(m *Mixer) Latent
+}
+
+// RGB is an 8-bit sRGB color.
+type RGB [3]uint8
+
+// FloatRGB is an sRGB color with channels in 0..1.
+type FloatRGB [3]float64
+
+// LinearFloatRGB is a linear-light RGB color with channels in 0..1.
+type LinearFloatRGB [3]float64
+
+func (c RGB) ToLatent(m *Mixer) Latent            { return m.RGBToLatent(c) }
+func (c FloatRGB) ToLatent(m *Mixer) Latent       { return m.FloatRGBToLatent(c) }
+func (c LinearFloatRGB) ToLatent(m *Mixer) Latent { return m.LinearFloatRGBToLatent(c) }
+
+// Errors returned by Mix.
+var (
+	ErrNoColors = errors.New("mixbox: no colors to mix")
+	ErrWeights  = errors.New("mixbox: invalid mixing weights")
+)
+
+// Mix mixes colors in the given proportions using the default Mixer. See
+// Mixer.Mix.
+func Mix(colors []Color, weights []float64) (Latent, error) {
+	return Default().Mix(colors, weights)
+}
+
+// Mix mixes colors in the given proportions. Weights are relative amounts
+// of paint: they are normalized to sum to one, so {3, 5, 2} and
+// {0.3, 0.5, 0.2} give the same mix. There must be one finite,
+// non-negative weight per color, at least one must be positive and their
+// sum must be finite.
+func (m *Mixer) Mix(colors []Color, weights []float64) (Latent, error) {
+	if len(colors) == 0 {
+		return Latent{}, ErrNoColors
+	}
+	if len(weights) != len(colors) {
+		return Latent{}, fmt.Errorf("%w: got %d weights for %d colors", ErrWeights, len(weights), len(colors))
+	}
+
+	var total float64
+	for i, w := range weights {
+		if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			return Latent{}, fmt.Errorf("%w: weight %d is %v", ErrWeights, i, w)
+		}
+		total += w
+	}
+	if total == 0 {
+		return Latent{}, fmt.Errorf("%w: weights sum to zero", ErrWeights)
+	}
+	if math.IsInf(total, 0) {
+		return Latent{}, fmt.Errorf("%w: weights sum to more than %v", ErrWeights, math.MaxFloat64)
+	}
+
+	var mixed Latent
+	for i, c := range colors {
+		if weights[i] == 0 {
+			continue
+		}
+		mixed = mixed.Add(c.ToLatent(m).Scale(weights[i] / total))
+	}
+	return mixed, nil
+}
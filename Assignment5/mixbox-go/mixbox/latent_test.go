@@ -0,0 +1,42 @@
+package mixbox
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestMix(t *testing.T) {
+	m := testMixer(t)
+	yellow, blue := RGB{254, 236, 0}, RGB{25, 0, 89}
+	want := m.Lerp(yellow, blue, 0.25)
+	for _, weights := range [][]float64{{3, 1}, {0.75, 0.25}, {3e300, 1e300}} {
+		l, err := m.Mix([]Color{yellow, blue}, weights)
+		if err != nil {
+			t.Fatalf("Mix(%v): %v", weights, err)
+		}
+		if got := l.RGB(); got != want {
+			t.Errorf("Mix(%v) = %v, want %v", weights, got, want)
+		}
+	}
+}
+
+func TestMixErrors(t *testing.T) {
+	m := testMixer(t)
+	two := []Color{RGB{255, 0, 0}, RGB{0, 0, 255}}
+	if _, err := m.Mix(nil, nil); !errors.Is(err, ErrNoColors) {
+		t.Errorf("Mix(nil) err = %v, want ErrNoColors", err)
+	}
+	for _, weights := range [][]float64{
+		{1},
+		{-1, 2},
+		{math.NaN(), 1},
+		{math.Inf(1), 1},
+		{0, 0},
+		{math.MaxFloat64, math.MaxFloat64},
+	} {
+		if _, err := m.Mix(two, weights); !errors.Is(err, ErrWeights) {
+			t.Errorf("Mix(%v) err = %v, want ErrWeights", weights, err)
+		}
+	}
+}
@@ -0,0 +1,10 @@
+//go:build !mixbox_nolut
+
+package mixbox
+
+import _ "embed"
+
+// embeddedLUT is the default table, decoded on first use by Default.
+//
+//go:embed lut.dat
+var embeddedLUT []byte
@@ -0,0 +1,245 @@
+package mixbox
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/zlib"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// LUTFormat identifies how a lookup table was encoded.
+type LUTFormat int
+
+const (
+	// FormatUnknown is reported for data that matches none of the known encodings.
+	FormatUnknown LUTFormat = iota
+	// FormatRaw is an already decoded table: the header followed by one
+	// 64x64x64 plane per coefficient, optionally with its trailing padding.
+	FormatRaw
+	// FormatBase64Deflate is base64 text wrapping a raw deflate stream, as
+	// embedded in the reference JavaScript implementation.
+	FormatBase64Deflate
+	// FormatBase64Zlib is base64 text wrapping a zlib stream.
+	FormatBase64Zlib
+	// FormatInterleaved is the layout of lut.dat: the header followed by the
+	// three coefficients of each cell stored next to each other.
+	FormatInterleaved
+)
+
+func (f LUTFormat) String() string {
+	switch f {
+	case FormatRaw:
+		return "raw"
+	case FormatBase64Deflate:
+		return "base64+deflate"
+	case FormatBase64Zlib:
+		return "base64+zlib"
+	case FormatInterleaved:
+		return "interleaved"
+	}
+	return "unknown"
+}
+
+const (
+	lutHeaderSize = 192
+	lutCells      = 64 * 64 * 64
+	// lutDataSize is the size of a decoded table without padding.
+	lutDataSize = lutHeaderSize + 3*lutCells
+	// lutPadding keeps the trilinear lookup in bounds at the far edge of the cube.
+	lutPadding = 4161
+	// lutSize is the size of a decoded table as used by a Mixer.
+	lutSize = lutDataSize + lutPadding
+)
+
+// ReferenceLUTChecksum is the hex encoded SHA-256 of the table embedded in
+// this module (lut.dat) in FormatRaw, without padding. It detects a table
+// that differs from the one shipped here; it says nothing about whether that
+// table matches upstream Mixbox.
+const ReferenceLUTChecksum = "60c6cc6394055e601ddfccf2ccaae244ec071d0822ba20c783df845bfb11bc59"
+
+// Errors wrapped by LUTError. Use errors.Is to tell them apart.
+var (
+	ErrLUTSize     = errors.New("mixbox: LUT has wrong size")
+	ErrLUTHeader   = errors.New("mixbox: LUT has bad header")
+	ErrLUTCorrupt  = errors.New("mixbox: LUT stream is corrupt")
+	ErrLUTChecksum = errors.New("mixbox: LUT checksum mismatch")
+)
+
+// LUTError reports why a lookup table could not be loaded.
+type LUTError struct {
+	Format LUTFormat // encoding that was detected
+	Err    error     // one of ErrLUTSize, ErrLUTHeader, ErrLUTCorrupt, ErrLUTChecksum
+	Detail string
+}
+
+func (e *LUTError) Error() string {
+	return fmt.Sprintf("%v (%v): %s", e.Err, e.Format, e.Detail)
+}
+
+func (e *LUTError) Unwrap() error {
+	return e.Err
+}
+
+// DetectLUTFormat reports which encoding data appears to use. It only looks
+// at the shape of the data; ParseLUT does the full validation.
+func DetectLUTFormat(data []byte) LUTFormat {
+	format, _ := detectLUT(data)
+	return format
+}
+
+// ParseLUT decodes a lookup table in any of the supported formats and checks
+// it against ReferenceLUTChecksum. The result is ready to pass to NewMixer.
+func ParseLUT(data []byte) ([]byte, error) {
+	return ParseLUTWithChecksum(data, ReferenceLUTChecksum)
+}
+
+// ParseLUTWithChecksum is like ParseLUT but checks the decoded table against
+// the given hex encoded SHA-256 instead. An empty checksum skips the check,
+// which allows custom tables.
+func ParseLUTWithChecksum(data []byte, checksum string) ([]byte, error) {
+	format, compressed := detectLUT(data)
+
+	var table []byte
+	switch format {
+	case FormatRaw:
+		if len(data) != lutDataSize && len(data) != lutSize {
+			return nil, &LUTError{format, ErrLUTSize, fmt.Sprintf("got %d bytes, want %d or %d", len(data), lutDataSize, lutSize)}
+		}
+		table = make([]byte, lutSize)
+		copy(table, data[:lutDataSize])
+	case FormatInterleaved:
+		table = deinterleaveLUT(data)
+	case FormatBase64Deflate, FormatBase64Zlib:
+		var err error
+		table, err = inflateLUT(format, compressed)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, &LUTError{format, ErrLUTHeader, "data is neither a decoded table nor base64 text"}
+	}
+
+	if !validLUTHeader(table) {
+		return nil, &LUTError{format, ErrLUTHeader, "decoded table does not start with the Mixbox banner"}
+	}
+
+	if checksum != "" {
+		sum := sha256.Sum256(table[:lutDataSize])
+		if got := hex.EncodeToString(sum[:]); got != checksum {
+			return nil, &LUTError{format, ErrLUTChecksum, fmt.Sprintf("got %s, want %s", got, checksum)}
+		}
+	}
+
+	return table, nil
+}
+
+// detectLUT classifies data and, for base64 formats, returns the decoded
+// compressed stream.
+func detectLUT(data []byte) (LUTFormat, []byte) {
+	if validLUTHeader(data) {
+		if len(data) == lutDataSize+3*lutPadding {
+			return FormatInterleaved, nil
+		}
+		return FormatRaw, nil
+	}
+
+	text := make([]byte, 0, len(data))
+	for _, c := range data {
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+		case c >= 'A' && c <= 'Z', c >= 'a' && c <= 'z', c >= '0' && c <= '9', c == '+', c == '/', c == '=':
+			text = append(text, c)
+		default:
+			return FormatUnknown, nil
+		}
+	}
+	if len(text) == 0 {
+		return FormatUnknown, nil
+	}
+
+	compressed, err := base64.StdEncoding.DecodeString(string(text))
+	if err != nil {
+		// Still base64 in shape; let inflateLUT report the corruption.
+		return FormatBase64Deflate, nil
+	}
+	if len(compressed) >= 2 && compressed[0]&0x0f == 8 && (uint(compressed[0])<<8|uint(compressed[1]))%31 == 0 {
+		return FormatBase64Zlib, compressed
+	}
+	return FormatBase64Deflate, compressed
+}
+
+// inflateLUT decompresses and delta decodes a compressed table.
+func inflateLUT(format LUTFormat, compressed []byte) ([]byte, error) {
+	if compressed == nil {
+		return nil, &LUTError{format, ErrLUTCorrupt, "invalid base64 text"}
+	}
+
+	var r io.ReadCloser
+	if format == FormatBase64Zlib {
+		zr, err := zlib.NewReader(bytes.NewReader(compressed))
+		if err != nil {
+			return nil, &LUTError{format, ErrLUTCorrupt, err.Error()}
+		}
+		r = zr
+	} else {
+		r = flate.NewReader(bytes.NewReader(compressed))
+	}
+	defer r.Close()
+
+	// Read one byte more than expected so oversized streams are detected
+	// without decompressing all of them.
+	output, err := io.ReadAll(io.LimitReader(r, lutDataSize+1))
+	if err != nil {
+		return nil, &LUTError{format, ErrLUTCorrupt, err.Error()}
+	}
+	if len(output) != lutDataSize {
+		return nil, &LUTError{format, ErrLUTSize, fmt.Sprintf("decompressed to %d bytes, want %d", len(output), lutDataSize)}
+	}
+
+	// Undo the delta encoding: each row of 64 bytes starts from 127 and
+	// every byte stores the difference to its predecessor, biased by 127.
+	for i := 0; i < len(output); i++ {
+		var prev byte
+		if i&63 != 0 {
+			prev = output[i-1]
+		} else {
+			prev = 127
+		}
+		output[i] = prev + (output[i] - 127)
+	}
+
+	return append(output, make([]byte, lutPadding)...), nil
+}
+
+// deinterleaveLUT converts the lut.dat layout into one plane per coefficient.
+func deinterleaveLUT(data []byte) []byte {
+	table := make([]byte, lutSize)
+	copy(table, data[:lutHeaderSize])
+	cells := data[lutHeaderSize:]
+	for i := 0; i < lutCells; i++ {
+		table[lutHeaderSize+i] = cells[3*i]
+		table[lutHeaderSize+lutCells+i] = cells[3*i+1]
+		table[lutHeaderSize+2*lutCells+i] = cells[3*i+2]
+	}
+	return table
+}
+
+// validLUTHeader reports whether data starts with the printable license
+// banner that every Mixbox table carries.
+func validLUTHeader(data []byte) bool {
+	if len(data) < lutHeaderSize {
+		return false
+	}
+	header := data[:lutHeaderSize]
+	for _, c := range header {
+		if c < 0x20 || c > 0x7e {
+			return false
+		}
+	}
+	return bytes.Contains(header, []byte("MIXBOX"))
+}
@@ -0,0 +1,135 @@
+package mixbox
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/zlib"
+	"encoding/base64"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+// compressLUT encodes a decoded table the way the reference JavaScript
+// implementation embeds it: delta encoded rows, deflated, in base64 text.
+func compressLUT(t *testing.T, table []byte, format LUTFormat) []byte {
+	t.Helper()
+	data := table[:lutDataSize]
+	delta := make([]byte, len(data))
+	for i := range data {
+		prev := byte(127)
+		if i&63 != 0 {
+			prev = data[i-1]
+		}
+		delta[i] = data[i] - prev + 127
+	}
+	return base64Stream(t, delta, format)
+}
+
+// base64Stream compresses data as format and encodes it as base64 text.
+func base64Stream(t *testing.T, data []byte, format LUTFormat) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	var w io.WriteCloser
+	if format == FormatBase64Zlib {
+		w = zlib.NewWriter(&buf)
+	} else {
+		var err error
+		if w, err = flate.NewWriter(&buf, flate.BestSpeed); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := w.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return []byte(base64.StdEncoding.EncodeToString(buf.Bytes()))
+}
+
+func TestParseLUT(t *testing.T) {
+	table := embeddedTable(t)
+	tampered := append([]byte(nil), table...)
+	tampered[lutHeaderSize+1000]++
+	badHeader := append([]byte(nil), table...)
+	copy(badHeader, strings.Repeat("x", lutHeaderSize))
+
+	tests := []struct {
+		name     string
+		data     []byte
+		checksum string
+		format   LUTFormat
+		err      error
+	}{
+		{"interleaved", embeddedLUT, ReferenceLUTChecksum, FormatInterleaved, nil},
+		{"raw", table[:lutDataSize], ReferenceLUTChecksum, FormatRaw, nil},
+		{"raw padded", table, ReferenceLUTChecksum, FormatRaw, nil},
+		{"base64 deflate", compressLUT(t, table, FormatBase64Deflate), ReferenceLUTChecksum, FormatBase64Deflate, nil},
+		{"base64 zlib", compressLUT(t, table, FormatBase64Zlib), ReferenceLUTChecksum, FormatBase64Zlib, nil},
+		{"base64 with line breaks", bytes.ReplaceAll(compressLUT(t, table, FormatBase64Deflate), []byte("A"), []byte("\nA")), ReferenceLUTChecksum, FormatBase64Deflate, nil},
+		{"custom table without checksum", tampered, "", FormatRaw, nil},
+
+		{"raw too short", table[:lutDataSize-1], ReferenceLUTChecksum, FormatRaw, ErrLUTSize},
+		{"raw too long", append(table[:lutSize:lutSize], 0), ReferenceLUTChecksum, FormatRaw, ErrLUTSize},
+		{"deflate too short", base64Stream(t, make([]byte, 100), FormatBase64Deflate), ReferenceLUTChecksum, FormatBase64Deflate, ErrLUTSize},
+		{"zlib too long", base64Stream(t, make([]byte, lutDataSize+1), FormatBase64Zlib), ReferenceLUTChecksum, FormatBase64Zlib, ErrLUTSize},
+		{"binary", []byte{0, 1, 2, 0xff}, ReferenceLUTChecksum, FormatUnknown, ErrLUTHeader},
+		{"empty", nil, ReferenceLUTChecksum, FormatUnknown, ErrLUTHeader},
+		{"raw without banner", badHeader, "", FormatUnknown, ErrLUTHeader},
+		{"inflates without banner", compressLUT(t, badHeader, FormatBase64Deflate), "", FormatBase64Deflate, ErrLUTHeader},
+		{"invalid base64", []byte("abc"), ReferenceLUTChecksum, FormatBase64Deflate, ErrLUTCorrupt},
+		{"invalid deflate", []byte("////"), ReferenceLUTChecksum, FormatBase64Deflate, ErrLUTCorrupt},
+		{"invalid zlib checksum", corruptZlib(compressLUT(t, table, FormatBase64Zlib)), ReferenceLUTChecksum, FormatBase64Zlib, ErrLUTCorrupt},
+		{"tampered", tampered, ReferenceLUTChecksum, FormatRaw, ErrLUTChecksum},
+		{"wrong checksum", embeddedLUT, strings.Repeat("0", 64), FormatInterleaved, ErrLUTChecksum},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DetectLUTFormat(tt.data); got != tt.format {
+				t.Errorf("DetectLUTFormat = %v, want %v", got, tt.format)
+			}
+			got, err := ParseLUTWithChecksum(tt.data, tt.checksum)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("err = %v, want %v", err, tt.err)
+				}
+				var lerr *LUTError
+				if !errors.As(err, &lerr) || lerr.Format != tt.format {
+					t.Errorf("err = %#v, want a LUTError for format %v", err, tt.format)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := table
+			if tt.checksum == "" {
+				want = tt.data
+			}
+			if len(got) != lutSize || !bytes.Equal(got[:lutDataSize], want[:lutDataSize]) {
+				t.Error("decoded table differs from the expected table")
+			}
+		})
+	}
+}
+
+// corruptZlib flips a bit in the Adler-32 trailer of a base64 zlib stream.
+func corruptZlib(text []byte) []byte {
+	stream, err := base64.StdEncoding.DecodeString(string(text))
+	if err != nil {
+		panic(err)
+	}
+	stream[len(stream)-1] ^= 1
+	return []byte(base64.StdEncoding.EncodeToString(stream))
+}
+
+func TestNewMixerShortTable(t *testing.T) {
+	if _, err := NewMixer(nil); !errors.Is(err, ErrNoLUT) {
+		t.Errorf("NewMixer(nil) err = %v, want ErrNoLUT", err)
+	}
+	if _, err := NewMixer(make([]byte, lutDataSize)); !errors.Is(err, ErrLUTSize) {
+		t.Errorf("NewMixer(unpadded) err = %v, want ErrLUTSize", err)
+	}
+}
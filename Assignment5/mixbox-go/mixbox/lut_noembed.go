@@ -0,0 +1,7 @@
+//go:build mixbox_nolut
+
+package mixbox
+
+// embeddedLUT is empty when built with the mixbox_nolut tag; callers must
+// install a table with InitLUT, LoadLUTFromFile or SetDefault.
+var embeddedLUT []byte
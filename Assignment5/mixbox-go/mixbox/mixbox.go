@@ -4,19 +4,20 @@ package mixbox
 import (
 	"math"
 	"os"
-	"bytes"
-	"compress/flate"
-	"encoding/base64"
 	"fmt"
-	"io"
 )
 
 const LatentSize = 7
 
-var lut []uint8
-
-func InitLUT(lutData []uint8) {
-	lut = lutData
+// InitLUT replaces the default Mixer with one built from lutData, which may
+// be in any format understood by ParseLUT.
+func InitLUT(lutData []uint8) error {
+	table, err := ParseLUT(lutData)
+	if err != nil {
+		return err
+	}
+	SetDefault(newMixer(table))
+	return nil
 }
 
 func LoadLUTFromFile(path string) error {
@@ -24,8 +25,7 @@ func LoadLUTFromFile(path string) error {
 	if err != nil {
 		return fmt.Errorf("failed to read LUT file: %w", err)
 	}
-	InitLUT(data)
-	return nil
+	return InitLUT(data)
 }
 
 
@@ -146,76 +146,19 @@ func evalPolynomial(c0, c1, c2, c3 float64) [3]float64 {
 	return [3]float64{r, g, b}
 }
 
-func RGBToLatent(rgb [3]uint8) [LatentSize]float64 {
-	return FloatRGBToLatent([3]float64{
-		float64(rgb[0]) / 255.0,
-		float64(rgb[1]) / 255.0,
-		float64(rgb[2]) / 255.0,
-	})
+func RGBToLatent(rgb [3]uint8) Latent {
+	return Default().RGBToLatent(rgb)
 }
 
-func FloatRGBToLatent(rgb [3]float64) [LatentSize]float64 {
-	r := clamp01(rgb[0])
-	g := clamp01(rgb[1])
-	b := clamp01(rgb[2])
-
-	x := r * 63.0
-	y := g * 63.0
-	z := b * 63.0
-
-	ix := int(x)
-	iy := int(y)
-	iz := int(z)
-
-	tx := x - float64(ix)
-	ty := y - float64(iy)
-	tz := z - float64(iz)
-
-	xyz := (ix + iy*64 + iz*64*64) & 0x3FFFF
-
-	var c0, c1, c2 float64
-
-	get := func(offset int) float64 {
-		return float64(lut[xyz+offset])
-	}
-
-	weights := []float64{
-		(1.0 - tx) * (1.0 - ty) * (1.0 - tz),
-		tx * (1.0 - ty) * (1.0 - tz),
-		(1.0 - tx) * ty * (1.0 - tz),
-		tx * ty * (1.0 - tz),
-		(1.0 - tx) * (1.0 - ty) * tz,
-		tx * (1.0 - ty) * tz,
-		(1.0 - tx) * ty * tz,
-		tx * ty * tz,
-	}
-
-	offsets := []int{192, 193, 256, 257, 4288, 4289, 4352, 4353}
-
-	for i := 0; i < 8; i++ {
-		w := weights[i]
-		c0 += w * get(offsets[i])
-		c1 += w * get(offsets[i] + 262144)
-		c2 += w * get(offsets[i] + 524288)
-	}
-
-	c0 /= 255.0
-	c1 /= 255.0
-	c2 /= 255.0
-
-	c3 := 1.0 - (c0 + c1 + c2)
-
-	mix := evalPolynomial(c0, c1, c2, c3)
+func FloatRGBToLatent(rgb [3]float64) Latent {
+	return Default().FloatRGBToLatent(rgb)
+}
 
-	return [LatentSize]float64{
-		c0, c1, c2, c3,
-		r - mix[0],
-		g - mix[1],
-		b - mix[2],
-	}
+func LinearFloatRGBToLatent(rgb [3]float64) Latent {
+	return Default().LinearFloatRGBToLatent(rgb)
 }
 
-func LatentToRGB(latent [LatentSize]float64) [3]uint8 {
+func LatentToRGB(latent Latent) [3]uint8 {
 	rgb := evalPolynomial(latent[0], latent[1], latent[2], latent[3])
 	return [3]uint8{
 		uint8(clamp01(rgb[0]+latent[4])*255.0 + 0.5),
@@ -224,60 +167,37 @@ func LatentToRGB(latent [LatentSize]float64) [3]uint8 {
 	}
 }
 
-func Lerp(rgb1, rgb2 [3]uint8, t float64) [3]uint8 {
-	latent1 := RGBToLatent(rgb1)
-	latent2 := RGBToLatent(rgb2)
-
-	var mixed [LatentSize]float64
-	for i := 0; i < LatentSize; i++ {
-		mixed[i] = (1.0 - t) * latent1[i] + t * latent2[i]
+func LatentToFloatRGB(latent Latent) [3]float64 {
+	rgb := evalPolynomial(latent[0], latent[1], latent[2], latent[3])
+	return [3]float64{
+		clamp01(rgb[0] + latent[4]),
+		clamp01(rgb[1] + latent[5]),
+		clamp01(rgb[2] + latent[6]),
 	}
-
-	return LatentToRGB(mixed)
 }
 
-// decompress takes a raw base64-encoded, deflate-compressed string and returns the decompressed LUT data.
-func decompress(input string) ([]byte, error) {
-	// Step 1: Base64 decode the input string.
-	data, err := base64.StdEncoding.DecodeString(input)
-	if err != nil {
-		return nil, fmt.Errorf("base64 decode failed: %v", err)
+func LatentToLinearFloatRGB(latent Latent) [3]float64 {
+	rgb := LatentToFloatRGB(latent)
+	return [3]float64{
+		srgbToLinear(rgb[0]),
+		srgbToLinear(rgb[1]),
+		srgbToLinear(rgb[2]),
 	}
+}
 
-	// Step 2: Decompress the raw deflate data using compress/flate.
-	reader := flate.NewReader(bytes.NewReader(data))
-	defer reader.Close()
-
-	var buf bytes.Buffer
-	if _, err := io.Copy(&buf, reader); err != nil {
-		return nil, fmt.Errorf("flate decompression failed: %v", err)
-	}
-	output := buf.Bytes()
-
-	// Step 3: Process the output bytes as in the Python version:
-	// For each index i, output[i] = (output[i-1] if (i & 63) != 0 else 127) + (output[i] - 127)
-	for i := 0; i < len(output); i++ {
-		var prev byte
-		if i&63 != 0 {
-			prev = output[i-1]
-		} else {
-			prev = 127
-		}
-		output[i] = prev + (output[i] - 127)
-	}
+func Lerp(rgb1, rgb2 [3]uint8, t float64) [3]uint8 {
+	return Default().Lerp(rgb1, rgb2, t)
+}
 
-	// Step 4: Append 4161 zeros.
-	output = append(output, make([]byte, 4161)...)
+func LerpFloat(rgb1, rgb2 [3]float64, t float64) [3]float64 {
+	return Default().LerpFloat(rgb1, rgb2, t)
+}
 
-	return output, nil
+func LerpLinearFloat(rgb1, rgb2 [3]float64, t float64) [3]float64 {
+	return Default().LerpLinearFloat(rgb1, rgb2, t)
 }
 
-// DecompressAndInitLUT decompresses the LUT from a raw string and initializes the global LUT.
+// DecompressAndInitLUT decompresses the LUT from a raw string and installs it as the default Mixer.
 func DecompressAndInitLUT(lutString string) error {
-	data, err := decompress(lutString)
-	if err != nil {
-		return err
-	}
-	InitLUT(data)
-	return nil
+	return InitLUT([]byte(lutString))
 }
\ No newline at end of file
@@ -0,0 +1,200 @@
+package mixbox
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrNoLUT is returned by NewMixer when it is given no table at all.
+var ErrNoLUT = errors.New("mixbox: no LUT data")
+
+// Mixer mixes colors through a single lookup table. A Mixer never changes
+// after it is created, so one value can be shared by any number of
+// goroutines, and Mixers built from different tables can be used side by side.
+type Mixer struct {
+	lut   []uint8
+	cache *LatentCache
+}
+
+// An Option configures a Mixer.
+type Option func(*Mixer) error
+
+// NewMixer returns a Mixer that reads its coefficients from lut, which must
+// be a decoded table as returned by ParseLUT. The Mixer
+// keeps a reference to lut; the caller must not modify it afterwards.
+func NewMixer(lut []uint8, opts ...Option) (*Mixer, error) {
+	if len(lut) == 0 {
+		return nil, ErrNoLUT
+	}
+	if len(lut) < lutSize {
+		return nil, &LUTError{FormatRaw, ErrLUTSize, fmt.Sprintf("got %d bytes, want at least %d", len(lut), lutSize)}
+	}
+	return newMixer(lut).With(opts...)
+}
+
+func newMixer(lut []uint8) *Mixer {
+	return &Mixer{lut: lut}
+}
+
+// With returns a copy of m with opts applied; m itself is unchanged.
+func (m *Mixer) With(opts ...Option) (*Mixer, error) {
+	c := *m
+	for _, opt := range opts {
+		if err := opt(&c); err != nil {
+			return nil, err
+		}
+	}
+	return &c, nil
+}
+
+var (
+	defaultMixer atomic.Pointer[Mixer]
+	defaultOnce  sync.Once
+)
+
+// Default returns the Mixer used by the package-level functions. Unless
+// SetDefault or InitLUT installed another one first, the embedded table is
+// decoded on the first call. Default panics if the package was built with
+// the mixbox_nolut tag and no table has been installed; use DefaultOK to
+// check first.
+func Default() *Mixer {
+	m, ok := DefaultOK()
+	if !ok {
+		panic("mixbox: no LUT loaded; call InitLUT or build without the mixbox_nolut tag")
+	}
+	return m
+}
+
+// DefaultOK is like Default but reports false instead of panicking when
+// there is no default Mixer, because the package was built with the
+// mixbox_nolut tag and no table has been installed.
+func DefaultOK() (*Mixer, bool) {
+	if m := defaultMixer.Load(); m != nil {
+		return m, true
+	}
+	defaultOnce.Do(func() {
+		if embeddedLUT == nil {
+			return
+		}
+		table, err := ParseLUT(embeddedLUT)
+		if err != nil {
+			panic("mixbox: embedded LUT is invalid: " + err.Error())
+		}
+		defaultMixer.CompareAndSwap(nil, newMixer(table))
+	})
+	m := defaultMixer.Load()
+	return m, m != nil
+}
+
+// SetDefault makes m the Mixer used by the package-level functions. Calls
+// that are already running keep using the Mixer they started with.
+func SetDefault(m *Mixer) {
+	if m == nil {
+		panic("mixbox: SetDefault called with nil Mixer")
+	}
+	defaultMixer.Store(m)
+}
+
+// cornerOffsets locate the eight LUT cells around a lookup point relative
+// to its cell index; the 192 skips the table header.
+var cornerOffsets = [8]int{192, 193, 256, 257, 4288, 4289, 4352, 4353}
+
+func (m *Mixer) RGBToLatent(rgb [3]uint8) Latent {
+	if m.cache != nil {
+		return m.cache.Latent(rgb)
+	}
+	return m.FloatRGBToLatent([3]float64{
+		float64(rgb[0]) / 255.0,
+		float64(rgb[1]) / 255.0,
+		float64(rgb[2]) / 255.0,
+	})
+}
+
+func (m *Mixer) FloatRGBToLatent(rgb [3]float64) Latent {
+	r := clamp01(rgb[0])
+	g := clamp01(rgb[1])
+	b := clamp01(rgb[2])
+
+	x := r * 63.0
+	y := g * 63.0
+	z := b * 63.0
+
+	ix := int(x)
+	iy := int(y)
+	iz := int(z)
+
+	tx := x - float64(ix)
+	ty := y - float64(iy)
+	tz := z - float64(iz)
+
+	xyz := (ix + iy*64 + iz*64*64) & 0x3FFFF
+
+	weights := [8]float64{
+		(1.0 - tx) * (1.0 - ty) * (1.0 - tz),
+		tx * (1.0 - ty) * (1.0 - tz),
+		(1.0 - tx) * ty * (1.0 - tz),
+		tx * ty * (1.0 - tz),
+		(1.0 - tx) * (1.0 - ty) * tz,
+		tx * (1.0 - ty) * tz,
+		(1.0 - tx) * ty * tz,
+		tx * ty * tz,
+	}
+
+	var c0, c1, c2 float64
+	lut := m.lut
+	for i, offset := range cornerOffsets {
+		w := weights[i]
+		c0 += w * float64(lut[xyz+offset])
+		c1 += w * float64(lut[xyz+offset+262144])
+		c2 += w * float64(lut[xyz+offset+524288])
+	}
+
+	c0 /= 255.0
+	c1 /= 255.0
+	c2 /= 255.0
+
+	c3 := 1.0 - (c0 + c1 + c2)
+
+	mix := evalPolynomial(c0, c1, c2, c3)
+
+	return Latent{
+		c0, c1, c2, c3,
+		r - mix[0],
+		g - mix[1],
+		b - mix[2],
+	}
+}
+
+func (m *Mixer) LinearFloatRGBToLatent(rgb [3]float64) Latent {
+	return m.FloatRGBToLatent([3]float64{
+		linearToSrgb(rgb[0]),
+		linearToSrgb(rgb[1]),
+		linearToSrgb(rgb[2]),
+	})
+}
+
+func (m *Mixer) LatentToRGB(latent Latent) [3]uint8 {
+	return LatentToRGB(latent)
+}
+
+func (m *Mixer) LatentToFloatRGB(latent Latent) [3]float64 {
+	return LatentToFloatRGB(latent)
+}
+
+func (m *Mixer) LatentToLinearFloatRGB(latent Latent) [3]float64 {
+	return LatentToLinearFloatRGB(latent)
+}
+
+func (m *Mixer) Lerp(rgb1, rgb2 [3]uint8, t float64) [3]uint8 {
+	return LatentToRGB(m.RGBToLatent(rgb1).Lerp(m.RGBToLatent(rgb2), t))
+}
+
+func (m *Mixer) LerpFloat(rgb1, rgb2 [3]float64, t float64) [3]float64 {
+	return LatentToFloatRGB(m.FloatRGBToLatent(rgb1).Lerp(m.FloatRGBToLatent(rgb2), t))
+}
+
+func (m *Mixer) LerpLinearFloat(rgb1, rgb2 [3]float64, t float64) [3]float64 {
+	return LatentToLinearFloatRGB(m.LinearFloatRGBToLatent(rgb1).Lerp(m.LinearFloatRGBToLatent(rgb2), t))
+}
@@ -0,0 +1,26 @@
+//go:build mixbox_nolut
+
+package mixbox
+
+import "testing"
+
+func TestDefaultWithoutTable(t *testing.T) {
+	resetDefault(t)
+	if m, ok := DefaultOK(); ok || m != nil {
+		t.Fatalf("DefaultOK() = %p, %v; want nil, false", m, ok)
+	}
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("Default did not panic without a table")
+			}
+		}()
+		Default()
+	}()
+
+	m := newMixer(make([]uint8, lutSize))
+	SetDefault(m)
+	if got, ok := DefaultOK(); !ok || got != m {
+		t.Errorf("after SetDefault: DefaultOK() = %p, %v; want %p, true", got, ok, m)
+	}
+}
@@ -0,0 +1,176 @@
+package mixbox
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+// embeddedTable returns the decoded embedded table, skipping the test when
+// the package is built with the mixbox_nolut tag.
+func embeddedTable(t testing.TB) []byte {
+	t.Helper()
+	if embeddedLUT == nil {
+		t.Skip("built without an embedded LUT")
+	}
+	table, err := ParseLUT(embeddedLUT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return table
+}
+
+// testMixer returns a Mixer over the embedded table.
+func testMixer(t testing.TB) *Mixer {
+	t.Helper()
+	m, err := NewMixer(embeddedTable(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+// resetDefault forgets the default Mixer, so that the next call to Default
+// starts from scratch, and restores it when the test ends.
+func resetDefault(t *testing.T) {
+	t.Helper()
+	prev := defaultMixer.Load()
+	t.Cleanup(func() {
+		defaultOnce = sync.Once{}
+		defaultMixer.Store(prev)
+	})
+	defaultOnce = sync.Once{}
+	defaultMixer.Store(nil)
+}
+
+// invertedTable returns a copy of table with every coefficient inverted, a
+// valid table that mixes differently.
+func invertedTable(table []byte) []byte {
+	inv := append([]byte(nil), table...)
+	for i := lutHeaderSize; i < lutDataSize; i++ {
+		inv[i] = 255 - inv[i]
+	}
+	return inv
+}
+
+type mixCase struct {
+	rgb1, rgb2 [3]uint8
+	t          float64
+}
+
+func randomMixCases(n int, seed int64) []mixCase {
+	rng := rand.New(rand.NewSource(seed))
+	rgb := func() [3]uint8 {
+		return [3]uint8{uint8(rng.Intn(256)), uint8(rng.Intn(256)), uint8(rng.Intn(256))}
+	}
+	cases := make([]mixCase, n)
+	for i := range cases {
+		cases[i] = mixCase{rgb(), rgb(), rng.Float64()}
+	}
+	return cases
+}
+
+// TestMixersSideBySide mixes with two Mixers over different tables from
+// many goroutines while the default Mixer is swapped between them. Run it
+// with -race.
+func TestMixersSideBySide(t *testing.T) {
+	table := embeddedTable(t)
+	a, err := NewMixer(table)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := NewMixer(invertedTable(table))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := randomMixCases(500, 1)
+	wantA := make([][3]uint8, len(cases))
+	wantB := make([][3]uint8, len(cases))
+	differ := 0
+	for i, c := range cases {
+		wantA[i] = a.Lerp(c.rgb1, c.rgb2, c.t)
+		wantB[i] = b.Lerp(c.rgb1, c.rgb2, c.t)
+		if wantA[i] != wantB[i] {
+			differ++
+		}
+	}
+	if differ == 0 {
+		t.Fatal("the two tables mix identically; the test proves nothing")
+	}
+
+	prev := Default()
+	defer SetDefault(prev)
+
+	var wg sync.WaitGroup
+	check := func(name string, m *Mixer, want [][3]uint8) {
+		defer wg.Done()
+		for i, c := range cases {
+			if got := m.Lerp(c.rgb1, c.rgb2, c.t); got != want[i] {
+				t.Errorf("%s: Lerp(%v, %v, %v) = %v, want %v", name, c.rgb1, c.rgb2, c.t, got, want[i])
+				return
+			}
+		}
+	}
+	for range 4 {
+		wg.Add(2)
+		go check("a", a, wantA)
+		go check("b", b, wantB)
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := range 1000 {
+			if i%2 == 0 {
+				SetDefault(a)
+			} else {
+				SetDefault(b)
+			}
+			c := cases[i%len(cases)]
+			got := Lerp(c.rgb1, c.rgb2, c.t)
+			if got != wantA[i%len(cases)] && got != wantB[i%len(cases)] {
+				t.Errorf("Lerp(%v, %v, %v) = %v, from neither table", c.rgb1, c.rgb2, c.t, got)
+				return
+			}
+		}
+	}()
+	wg.Wait()
+}
+
+// The embedded table is decoded once, by whichever goroutine asks first;
+// every later call returns the same Mixer without allocating.
+func TestDefaultDecodedOnce(t *testing.T) {
+	embeddedTable(t)
+	resetDefault(t)
+
+	got := make([]*Mixer, 8)
+	var wg sync.WaitGroup
+	for i := range got {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got[i] = Default()
+		}()
+	}
+	wg.Wait()
+	for i, m := range got {
+		if m == nil || m != got[0] {
+			t.Fatalf("goroutine %d got Mixer %p, goroutine 0 got %p", i, m, got[0])
+		}
+	}
+	if m, ok := DefaultOK(); !ok || m != got[0] {
+		t.Errorf("DefaultOK() = %p, %v; want %p, true", m, ok, got[0])
+	}
+	if allocs := testing.AllocsPerRun(10, func() { Default() }); allocs != 0 {
+		t.Errorf("Default allocates %v times per call after the first, want 0", allocs)
+	}
+}
+
+func TestSetDefaultBeforeFirstUse(t *testing.T) {
+	resetDefault(t)
+	m := newMixer(make([]uint8, lutSize))
+	SetDefault(m)
+	if got := Default(); got != m {
+		t.Errorf("Default() = %p, want the Mixer passed to SetDefault (%p)", got, m)
+	}
+}
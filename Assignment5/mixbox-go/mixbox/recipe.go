@@ -0,0 +1,282 @@
+package mixbox
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+// Recipe is a mix of palette colors that approximates a target color.
+type Recipe struct {
+	// Weights holds the proportion of each palette color, in palette order.
+	// The weights are non-negative and sum to one.
+	Weights []float64
+	// Latent and RGB are the mixed color the weights produce.
+	Latent Latent
+	RGB    [3]uint8
+	// Error is the Euclidean distance between the mixed and the target
+	// color in sRGB with channels in 0..1.
+	Error float64
+}
+
+// ErrMaxPigments is returned when a recipe may not use any pigment.
+var ErrMaxPigments = errors.New("mixbox: recipe must allow at least one pigment")
+
+const (
+	// recipeSubsetLimit caps how many pigment subsets are tried exhaustively;
+	// larger searches fall back to greedy selection.
+	recipeSubsetLimit = 4096
+	recipeIterations  = 300
+	// recipeMinWeight is the smallest weight kept in a recipe; anything
+	// below is dropped and the rest renormalized.
+	recipeMinWeight = 1e-4
+)
+
+// SolveRecipe finds a recipe for target using the default Mixer. See
+// Mixer.SolveRecipe.
+func SolveRecipe(target [3]uint8, palette [][3]uint8, maxPigments int) (Recipe, error) {
+	return Default().SolveRecipe(target, palette, maxPigments)
+}
+
+// SolveRecipe finds non-negative weights over at most maxPigments colors of
+// palette whose mix comes closest to target. Mixing is linear in latent
+// space, so the search runs there: for each candidate subset of pigments
+// the weights are optimized by projected gradient descent on the simplex,
+// minimizing the squared distance between the decoded mix, clamped to the
+// sRGB gamut as LatentToFloatRGB clamps it, and the target: the distance
+// reported as Recipe.Error. Small palettes are searched exhaustively; large
+// ones greedily, adding one pigment at a time.
+func (m *Mixer) SolveRecipe(target [3]uint8, palette [][3]uint8, maxPigments int) (Recipe, error) {
+	if len(palette) == 0 {
+		return Recipe{}, ErrNoColors
+	}
+	if maxPigments < 1 {
+		return Recipe{}, ErrMaxPigments
+	}
+	if maxPigments > len(palette) {
+		maxPigments = len(palette)
+	}
+
+	latents := make([]Latent, len(palette))
+	for i, c := range palette {
+		latents[i] = m.RGBToLatent(c)
+	}
+	goal := [3]float64{
+		float64(target[0]) / 255.0,
+		float64(target[1]) / 255.0,
+		float64(target[2]) / 255.0,
+	}
+
+	var best []float64
+	bestErr := math.Inf(1)
+	try := func(subset []int) {
+		weights, err := optimizeRecipe(latents, subset, goal)
+		if err < bestErr {
+			best, bestErr = weights, err
+		}
+	}
+
+	if countSubsets(len(palette), maxPigments) <= recipeSubsetLimit {
+		forEachSubset(len(palette), maxPigments, try)
+	} else {
+		var chosen []int
+		for len(chosen) < maxPigments {
+			roundBest, roundErr := -1, math.Inf(1)
+			for i := range palette {
+				if containsInt(chosen, i) {
+					continue
+				}
+				candidate := append(append([]int(nil), chosen...), i)
+				weights, err := optimizeRecipe(latents, candidate, goal)
+				if err < roundErr {
+					roundBest, roundErr = i, err
+				}
+				if err < bestErr {
+					best, bestErr = weights, err
+				}
+			}
+			if roundBest < 0 {
+				break
+			}
+			chosen = append(chosen, roundBest)
+		}
+	}
+
+	return m.recipeFromWeights(latents, best, goal), nil
+}
+
+// recipeFromWeights drops negligible weights and evaluates the final mix.
+func (m *Mixer) recipeFromWeights(latents []Latent, weights []float64, goal [3]float64) Recipe {
+	var total float64
+	for i, w := range weights {
+		if w < recipeMinWeight {
+			weights[i] = 0
+		}
+		total += weights[i]
+	}
+	var mixed Latent
+	for i, w := range weights {
+		weights[i] = w / total
+		mixed = mixed.Add(latents[i].Scale(weights[i]))
+	}
+	rgb := mixed.FloatRGB()
+	return Recipe{
+		Weights: weights,
+		Latent:  mixed,
+		RGB:     mixed.RGB(),
+		Error:   math.Sqrt(sqDist(rgb, goal)),
+	}
+}
+
+// optimizeRecipe returns the best weights over the pigments in subset and
+// their squared error, as full-length weight vectors.
+func optimizeRecipe(latents []Latent, subset []int, goal [3]float64) ([]float64, float64) {
+	k := len(subset)
+	w := make([]float64, k)
+	for i := range w {
+		w[i] = 1.0 / float64(k)
+	}
+	grad := make([]float64, k)
+	next := make([]float64, k)
+
+	err := recipeError(latents, subset, w, goal, nil)
+	step := 1.0
+	for iter := 0; iter < recipeIterations && k > 1; iter++ {
+		recipeError(latents, subset, w, goal, grad)
+		improved := false
+		for step > 1e-9 {
+			for i := range next {
+				next[i] = w[i] - step*grad[i]
+			}
+			projectSimplex(next)
+			if e := recipeError(latents, subset, next, goal, nil); e < err {
+				copy(w, next)
+				err = e
+				step *= 1.5
+				improved = true
+				break
+			}
+			step *= 0.5
+		}
+		if !improved {
+			break
+		}
+	}
+
+	weights := make([]float64, len(latents))
+	for i, p := range subset {
+		weights[p] = w[i]
+	}
+	return weights, err
+}
+
+// recipeError returns the squared distance between the target and the
+// clamped mix of subset with weights w. If grad is not nil it receives the
+// gradient of that error with respect to w; a channel clamped to 0 or 1
+// contributes nothing to it.
+func recipeError(latents []Latent, subset []int, w []float64, goal [3]float64, grad []float64) float64 {
+	var mixed Latent
+	for i, p := range subset {
+		mixed = mixed.Add(latents[p].Scale(w[i]))
+	}
+	rgb := evalPolynomial(mixed[0], mixed[1], mixed[2], mixed[3])
+	var diff, slope [3]float64
+	for c := 0; c < 3; c++ {
+		v := rgb[c] + mixed[4+c]
+		diff[c] = clamp01(v) - goal[c]
+		if v > 0 && v < 1 {
+			slope[c] = 1
+		}
+	}
+	if grad != nil {
+		// Jacobian of the polynomial with respect to the four pigment
+		// concentrations, by central differences.
+		const h = 1e-6
+		var jac [4][3]float64
+		for j := 0; j < 4; j++ {
+			lo, hi := mixed, mixed
+			lo[j] -= h
+			hi[j] += h
+			a := evalPolynomial(lo[0], lo[1], lo[2], lo[3])
+			b := evalPolynomial(hi[0], hi[1], hi[2], hi[3])
+			for c := 0; c < 3; c++ {
+				jac[j][c] = (b[c] - a[c]) / (2 * h)
+			}
+		}
+		for i, p := range subset {
+			var g float64
+			for c := 0; c < 3; c++ {
+				d := latents[p][4+c]
+				for j := 0; j < 4; j++ {
+					d += jac[j][c] * latents[p][j]
+				}
+				g += 2 * diff[c] * slope[c] * d
+			}
+			grad[i] = g
+		}
+	}
+	return diff[0]*diff[0] + diff[1]*diff[1] + diff[2]*diff[2]
+}
+
+// projectSimplex replaces v with its Euclidean projection onto the set of
+// non-negative vectors summing to one.
+func projectSimplex(v []float64) {
+	u := append([]float64(nil), v...)
+	sort.Sort(sort.Reverse(sort.Float64Slice(u)))
+	var sum, theta float64
+	for i, x := range u {
+		sum += x
+		if t := (sum - 1) / float64(i+1); x-t > 0 {
+			theta = t
+		}
+	}
+	for i := range v {
+		v[i] = math.Max(v[i]-theta, 0)
+	}
+}
+
+// forEachSubset calls fn with every non-empty subset of 0..n-1 holding at
+// most k elements. fn must not retain the slice.
+func forEachSubset(n, k int, fn func([]int)) {
+	subset := make([]int, 0, k)
+	var walk func(start int)
+	walk = func(start int) {
+		for i := start; i < n; i++ {
+			subset = append(subset, i)
+			fn(subset)
+			if len(subset) < k {
+				walk(i + 1)
+			}
+			subset = subset[:len(subset)-1]
+		}
+	}
+	walk(0)
+}
+
+// countSubsets returns the number of non-empty subsets of n items with at
+// most k elements, saturating at recipeSubsetLimit+1.
+func countSubsets(n, k int) int {
+	total, binom := 0, 1
+	for i := 1; i <= k; i++ {
+		binom = binom * (n - i + 1) / i
+		total += binom
+		if total > recipeSubsetLimit {
+			return recipeSubsetLimit + 1
+		}
+	}
+	return total
+}
+
+func containsInt(s []int, x int) bool {
+	for _, v := range s {
+		if v == x {
+			return true
+		}
+	}
+	return false
+}
+
+func sqDist(a, b [3]float64) float64 {
+	d0, d1, d2 := a[0]-b[0], a[1]-b[1], a[2]-b[2]
+	return d0*d0 + d1*d1 + d2*d2
+}
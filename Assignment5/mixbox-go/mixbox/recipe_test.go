@@ -0,0 +1,155 @@
+package mixbox
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// recipePalette is a small painter's palette: cadmium yellow, ultramarine,
+// quinacridone magenta, phthalo green, titanium white and ivory black.
+var recipePalette = [][3]uint8{
+	{254, 236, 0}, {25, 0, 89}, {128, 2, 46}, {0, 60, 50}, {255, 255, 255}, {22, 20, 20},
+}
+
+// checkRecipe checks the invariants every recipe keeps.
+func checkRecipe(t *testing.T, m *Mixer, r Recipe, target [3]uint8, palette [][3]uint8, maxPigments int) {
+	t.Helper()
+	if len(r.Weights) != len(palette) {
+		t.Fatalf("%d weights for %d palette colors", len(r.Weights), len(palette))
+	}
+	var total float64
+	used := 0
+	for i, w := range r.Weights {
+		if w < 0 || w > 1 {
+			t.Errorf("weight %d = %v, outside 0..1", i, w)
+		}
+		if w > 0 {
+			used++
+		}
+		total += w
+	}
+	if math.Abs(total-1) > 1e-9 {
+		t.Errorf("weights %v sum to %v, want 1", r.Weights, total)
+	}
+	if used > maxPigments {
+		t.Errorf("recipe uses %d pigments, want at most %d", used, maxPigments)
+	}
+	if r.RGB != r.Latent.RGB() {
+		t.Errorf("RGB %v, want the Latent's %v", r.RGB, r.Latent.RGB())
+	}
+	goal := [3]float64{float64(target[0]) / 255, float64(target[1]) / 255, float64(target[2]) / 255}
+	if want := math.Sqrt(sqDist(r.Latent.FloatRGB(), goal)); math.Abs(r.Error-want) > 1e-12 {
+		t.Errorf("Error %v, want %v", r.Error, want)
+	}
+}
+
+func TestSolveRecipeRecoversMix(t *testing.T) {
+	m := testMixer(t)
+	for _, tt := range []struct {
+		i, j int
+		wi   float64
+	}{{0, 1, 0.3}, {1, 4, 0.6}, {2, 0, 0.5}} {
+		mixed, err := m.Mix([]Color{RGB(recipePalette[tt.i]), RGB(recipePalette[tt.j])}, []float64{tt.wi, 1 - tt.wi})
+		if err != nil {
+			t.Fatal(err)
+		}
+		target := mixed.RGB()
+		r, err := m.SolveRecipe(target, recipePalette, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkRecipe(t, m, r, target, recipePalette, 2)
+		if r.Error > 1.5/255 {
+			t.Errorf("%d%% of %v with %v: recipe %v is off by %v", int(tt.wi*100), recipePalette[tt.i], recipePalette[tt.j], r.Weights, r.Error)
+		}
+		if math.Abs(r.Weights[tt.i]-tt.wi) > 0.02 || math.Abs(r.Weights[tt.j]-(1-tt.wi)) > 0.02 {
+			t.Errorf("%d%% of %v with %v: weights %v", int(tt.wi*100), recipePalette[tt.i], recipePalette[tt.j], r.Weights)
+		}
+	}
+}
+
+func TestSolveRecipePaletteColor(t *testing.T) {
+	m := testMixer(t)
+	for i, target := range recipePalette {
+		r, err := m.SolveRecipe(target, recipePalette, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkRecipe(t, m, r, target, recipePalette, 3)
+		if r.Weights[i] < 0.999 || r.RGB != m.Lerp(target, target, 0) {
+			t.Errorf("target %v: recipe %v mixes %v", target, r.Weights, r.RGB)
+		}
+	}
+}
+
+// A palette with many colors is searched greedily rather than exhaustively.
+func TestSolveRecipeGreedy(t *testing.T) {
+	m := testMixer(t)
+	var palette [][3]uint8
+	for i := 0; i < 30; i++ {
+		palette = append(palette, [3]uint8{uint8(i * 8), uint8(255 - i*8), uint8(i * 5)})
+	}
+	if countSubsets(len(palette), 3) <= recipeSubsetLimit {
+		t.Fatal("palette is small enough to search exhaustively")
+	}
+	target := palette[17]
+	r, err := m.SolveRecipe(target, palette, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkRecipe(t, m, r, target, palette, 3)
+	if r.Error > 1.0/255 {
+		t.Errorf("recipe %v for palette color %v is off by %v", r.Weights, target, r.Error)
+	}
+}
+
+func TestSolveRecipeErrors(t *testing.T) {
+	m := testMixer(t)
+	if _, err := m.SolveRecipe([3]uint8{}, nil, 2); !errors.Is(err, ErrNoColors) {
+		t.Errorf("empty palette: err = %v, want ErrNoColors", err)
+	}
+	for _, n := range []int{0, -1} {
+		if _, err := m.SolveRecipe([3]uint8{}, recipePalette, n); !errors.Is(err, ErrMaxPigments) {
+			t.Errorf("maxPigments %d: err = %v, want ErrMaxPigments", n, err)
+		}
+	}
+	// More pigments than the palette holds is the same as all of them.
+	target := [3]uint8{90, 120, 60}
+	r, err := m.SolveRecipe(target, recipePalette[:3], 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkRecipe(t, m, r, target, recipePalette[:3], 3)
+}
+
+// The optimizer's objective is the squared distance that Recipe.Error
+// reports, including for mixes that fall outside the sRGB gamut.
+func TestRecipeErrorMatchesReportedError(t *testing.T) {
+	m := testMixer(t)
+	latents := make([]Latent, len(recipePalette))
+	for i, c := range recipePalette {
+		latents[i] = m.RGBToLatent(c)
+	}
+	subset := []int{0, 1, 4}
+	goal := [3]float64{0.2, 0.9, 0.5}
+	clamped := 0
+	for _, w := range [][]float64{{1, 0, 0}, {0, 0, 1}, {0.5, 0.5, 0}, {0.2, 0.3, 0.5}, {0.9, 0, 0.1}} {
+		var mixed Latent
+		for i, p := range subset {
+			mixed = mixed.Add(latents[p].Scale(w[i]))
+		}
+		raw := evalPolynomial(mixed[0], mixed[1], mixed[2], mixed[3])
+		for c := range raw {
+			if v := raw[c] + mixed[4+c]; v < 0 || v > 1 {
+				clamped++
+			}
+		}
+		if got, want := recipeError(latents, subset, w, goal, nil), sqDist(mixed.FloatRGB(), goal); math.Abs(got-want) > 1e-12 {
+			t.Errorf("weights %v: recipeError %v, want %v", w, got, want)
+		}
+	}
+	if clamped == 0 {
+		t.Error("no mix left the gamut; the test proves nothing about clamping")
+	}
+}
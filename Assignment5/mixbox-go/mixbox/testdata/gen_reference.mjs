@@ -0,0 +1,40 @@
+// Regenerates reference_mixes.csv, the golden vectors used by mixbox.Verify,
+// with the official Mixbox JavaScript implementation. mixbox.js decodes the
+// copy of the table it ships with rather than reading lut.dat, and none of the
+// Go code runs. The vectors therefore catch a lut.dat or a Go port that
+// disagrees with mixbox.js, but not a flaw that mixbox.js shares.
+//
+// The cases (the first seven columns) are read from the existing file and
+// kept; only the expected colors are recomputed.
+//
+// Usage, from this directory:
+//
+//	npm install --no-save mixbox@2.0.0
+//	node gen_reference.mjs reference_mixes.csv > reference_mixes.csv.new
+//	mv reference_mixes.csv.new reference_mixes.csv
+
+import { readFileSync } from "node:fs";
+import { createRequire } from "node:module";
+
+const require = createRequire(import.meta.url);
+const mixbox = require("mixbox");
+const { version } = JSON.parse(
+  readFileSync(new URL("node_modules/mixbox/package.json", import.meta.url), "utf8"),
+);
+
+const lines = readFileSync(process.argv[2], "utf8").split("\n");
+const out = [
+  `# Golden vectors for mixbox.Verify, generated by gen_reference.mjs with mixbox.js ${version} (npm) on node ${process.version}:`,
+  "#   node gen_reference.mjs reference_mixes.csv",
+  "# r1,g1,b1,r2,g2,b2,t,r,g,b: 8-bit inputs, mixing ratio, float RGB result of mixbox.lerpFloat",
+];
+for (const line of lines) {
+  const text = line.trim();
+  if (text === "" || text.startsWith("#")) continue;
+  const f = text.split(",");
+  const rgb1 = f.slice(0, 3).map((v) => Number(v) / 255);
+  const rgb2 = f.slice(3, 6).map((v) => Number(v) / 255);
+  const mix = mixbox.lerpFloat(rgb1, rgb2, Number(f[6]));
+  out.push([...f.slice(0, 7), ...mix.map((v) => v.toFixed(10))].join(","));
+}
+process.stdout.write(out.join("\n") + "\n");
@@ -0,0 +1,153 @@
+package mixbox
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// referenceMixes holds the golden vectors. testdata/gen_reference.mjs
+// regenerates them with the reference JavaScript implementation; the file's
+// header records how the current values were produced.
+//
+//go:embed testdata/reference_mixes.csv
+var referenceMixes []byte
+
+// ReferenceCase is one golden vector: two 8-bit colors, a mixing ratio and
+// the float RGB result of the reference implementation.
+type ReferenceCase struct {
+	RGB1, RGB2 [3]uint8
+	T          float64
+	Want       [3]float64
+}
+
+// VerifyReport summarizes how far a Mixer deviates from reference outputs.
+type VerifyReport struct {
+	Cases int
+	// MaxDeviation and MeanDeviation compare the unrounded mix per channel,
+	// in 0..1 units.
+	MaxDeviation  float64
+	MeanDeviation float64
+	// Mismatches counts cases where Lerp's 8-bit result differs from the
+	// rounded reference; MaxDeviation8 is the largest such difference.
+	Mismatches    int
+	MaxDeviation8 int
+	// Worst is the case with the largest float deviation, if any deviated.
+	Worst ReferenceCase
+}
+
+// Identical reports whether every 8-bit result matched the reference.
+func (r VerifyReport) Identical() bool {
+	return r.Mismatches == 0
+}
+
+func (r VerifyReport) String() string {
+	return fmt.Sprintf("%d cases: max deviation %.3g, mean %.3g, %d 8-bit mismatches (max %d)",
+		r.Cases, r.MaxDeviation, r.MeanDeviation, r.Mismatches, r.MaxDeviation8)
+}
+
+// ReferenceCases returns the golden vectors shipped with the package.
+func ReferenceCases() ([]ReferenceCase, error) {
+	return ParseReferenceCases(bytes.NewReader(referenceMixes))
+}
+
+// ParseReferenceCases reads golden vectors in the CSV layout of
+// testdata/reference_mixes.csv: r1,g1,b1,r2,g2,b2,t,r,g,b per line, with
+// blank lines and lines starting with '#' ignored.
+func ParseReferenceCases(r io.Reader) ([]ReferenceCase, error) {
+	var cases []ReferenceCase
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || text[0] == '#' {
+			continue
+		}
+		fields := strings.Split(text, ",")
+		if len(fields) != 10 {
+			return nil, fmt.Errorf("mixbox: reference line %d: got %d fields, want 10", line, len(fields))
+		}
+		var c ReferenceCase
+		for i := 0; i < 6; i++ {
+			v, err := strconv.ParseUint(strings.TrimSpace(fields[i]), 10, 8)
+			if err != nil {
+				return nil, fmt.Errorf("mixbox: reference line %d: %w", line, err)
+			}
+			if i < 3 {
+				c.RGB1[i] = uint8(v)
+			} else {
+				c.RGB2[i-3] = uint8(v)
+			}
+		}
+		var floats [4]float64
+		for i := range floats {
+			v, err := strconv.ParseFloat(strings.TrimSpace(fields[6+i]), 64)
+			if err != nil {
+				return nil, fmt.Errorf("mixbox: reference line %d: %w", line, err)
+			}
+			floats[i] = v
+		}
+		c.T = floats[0]
+		c.Want = [3]float64{floats[1], floats[2], floats[3]}
+		cases = append(cases, c)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return cases, nil
+}
+
+// Verify mixes every shipped golden vector with m and reports how far the
+// results deviate from the reference implementation.
+func Verify(m *Mixer) (VerifyReport, error) {
+	cases, err := ReferenceCases()
+	if err != nil {
+		return VerifyReport{}, err
+	}
+	return VerifyCases(m, cases), nil
+}
+
+// VerifyCases is like Verify but checks m against the given cases.
+func VerifyCases(m *Mixer, cases []ReferenceCase) VerifyReport {
+	report := VerifyReport{Cases: len(cases)}
+	var sum float64
+	for _, c := range cases {
+		got := m.RGBToLatent(c.RGB1).Lerp(m.RGBToLatent(c.RGB2), c.T).FloatRGB()
+		got8 := m.Lerp(c.RGB1, c.RGB2, c.T)
+
+		mismatch := false
+		for i := 0; i < 3; i++ {
+			d := math.Abs(got[i] - c.Want[i])
+			sum += d
+			if d > report.MaxDeviation {
+				report.MaxDeviation = d
+				report.Worst = c
+			}
+
+			want8 := int(c.Want[i]*255.0 + 0.5)
+			d8 := int(got8[i]) - want8
+			if d8 < 0 {
+				d8 = -d8
+			}
+			if d8 != 0 {
+				mismatch = true
+			}
+			if d8 > report.MaxDeviation8 {
+				report.MaxDeviation8 = d8
+			}
+		}
+		if mismatch {
+			report.Mismatches++
+		}
+	}
+	if len(cases) > 0 {
+		report.MeanDeviation = sum / float64(3*len(cases))
+	}
+	return report
+}
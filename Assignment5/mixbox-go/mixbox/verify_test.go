@@ -0,0 +1,47 @@
+package mixbox
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestVerifyDefault(t *testing.T) {
+	embeddedTable(t)
+	report, err := Verify(Default())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Cases == 0 {
+		t.Fatal("no reference cases")
+	}
+	if !report.Identical() {
+		w := report.Worst
+		t.Errorf("%v; worst: Lerp(%v, %v, %v), want %v", report, w.RGB1, w.RGB2, w.T, w.Want)
+	}
+}
+
+func TestVerifyDetectsWrongTable(t *testing.T) {
+	m, err := NewMixer(invertedTable(embeddedTable(t)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err := Verify(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Identical() {
+		t.Errorf("Verify accepted an inverted table: %v", report)
+	}
+}
+
+func TestParseReferenceCasesErrors(t *testing.T) {
+	for _, in := range []string{
+		"1,2,3,4,5,6,0.5,0,0",
+		"1,2,3,4,5,256,0.5,0,0,0",
+		"1,2,3,4,5,6,half,0,0,0",
+	} {
+		if _, err := ParseReferenceCases(strings.NewReader(in)); err == nil {
+			t.Errorf("ParseReferenceCases(%q) succeeded", in)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package pigments
+
+import "sync/atomic"
+
+// The pigments from the Mixbox documentation. Opacity and staining are
+// typical of artist-grade paints; individual brands vary.
+var (
+	CadmiumYellow       = Pigment{"Cadmium Yellow", "PY35", [3]uint8{254, 236, 0}, Opaque, LowStaining}
+	HansaYellow         = Pigment{"Hansa Yellow", "PY74", [3]uint8{252, 211, 0}, SemiTransparent, ModerateStaining}
+	CadmiumOrange       = Pigment{"Cadmium Orange", "PO20", [3]uint8{255, 105, 0}, Opaque, LowStaining}
+	CadmiumRed          = Pigment{"Cadmium Red", "PR108", [3]uint8{255, 39, 2}, Opaque, LowStaining}
+	QuinacridoneMagenta = Pigment{"Quinacridone Magenta", "PR122", [3]uint8{128, 2, 46}, Transparent, HighStaining}
+	CobaltViolet        = Pigment{"Cobalt Violet", "PV14", [3]uint8{78, 0, 66}, SemiTransparent, LowStaining}
+	UltramarineBlue     = Pigment{"Ultramarine Blue", "PB29", [3]uint8{25, 0, 89}, Transparent, NonStaining}
+	CobaltBlue          = Pigment{"Cobalt Blue", "PB28", [3]uint8{0, 33, 133}, SemiTransparent, NonStaining}
+	PhthaloBlue         = Pigment{"Phthalo Blue", "PB15:3", [3]uint8{13, 27, 68}, Transparent, HighStaining}
+	PhthaloGreen        = Pigment{"Phthalo Green", "PG7", [3]uint8{0, 60, 50}, Transparent, HighStaining}
+	PermanentGreen      = Pigment{"Permanent Green", "", [3]uint8{7, 109, 22}, SemiOpaque, ModerateStaining}
+	SapGreen            = Pigment{"Sap Green", "", [3]uint8{107, 148, 4}, Transparent, ModerateStaining}
+	BurntSienna         = Pigment{"Burnt Sienna", "PBr7", [3]uint8{123, 72, 0}, SemiTransparent, LowStaining}
+)
+
+var builtin = MustPalette("Mixbox", []Pigment{
+	CadmiumYellow,
+	HansaYellow,
+	CadmiumOrange,
+	CadmiumRed,
+	QuinacridoneMagenta,
+	CobaltViolet,
+	UltramarineBlue,
+	CobaltBlue,
+	PhthaloBlue,
+	PhthaloGreen,
+	PermanentGreen,
+	SapGreen,
+	BurntSienna,
+})
+
+// Builtin returns the palette of pigments from the Mixbox documentation, in
+// order from yellow to brown.
+func Builtin() *Palette {
+	return builtin
+}
+
+var defaultPalette atomic.Pointer[Palette]
+
+// Default returns the palette servers resolve pigment names in: Builtin,
+// unless SetDefault installed another.
+func Default() *Palette {
+	if p := defaultPalette.Load(); p != nil {
+		return p
+	}
+	return builtin
+}
+
+// SetDefault makes p the palette returned by Default. Palettes never
+// change, so code still using the previous one is unaffected.
+func SetDefault(p *Palette) {
+	if p == nil {
+		panic("pigments: SetDefault called with nil Palette")
+	}
+	defaultPalette.Store(p)
+}
@@ -0,0 +1,140 @@
+package pigments
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/timf34/mixbox-go/colorparse"
+)
+
+// A palette file is a JSON object such as
+//
+//	{
+//	  "name": "Studio",
+//	  "pigments": [
+//	    {"name": "Cadmium Yellow", "code": "PY35", "color": "#feec00",
+//	     "opacity": "opaque", "staining": "low"},
+//	    {"name": "Brand Teal", "color": "oklch(0.6 0.1 190)"}
+//	  ]
+//	}
+//
+// Colors may be given in any CSS syntax colorparse understands and are
+// written back as hex. Opacity is one of transparent, semi-transparent,
+// semi-opaque or opaque; staining one of non-staining, low, moderate or
+// high. Both may be omitted.
+
+type jsonPigment struct {
+	Name     string   `json:"name"`
+	Code     string   `json:"code,omitempty"`
+	Color    string   `json:"color"`
+	Opacity  Opacity  `json:"opacity,omitempty"`
+	Staining Staining `json:"staining,omitempty"`
+}
+
+type jsonPalette struct {
+	Name     string    `json:"name,omitempty"`
+	Pigments []Pigment `json:"pigments"`
+}
+
+// MarshalJSON writes the pigment in the palette file format.
+func (p Pigment) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonPigment{p.Name, p.Code, colorparse.FromRGB(p.RGB).Hex(), p.Opacity, p.Staining})
+}
+
+// UnmarshalJSON reads a pigment in the palette file format.
+func (p *Pigment) UnmarshalJSON(data []byte) error {
+	var j jsonPigment
+	if err := strictUnmarshal(data, &j); err != nil {
+		return err
+	}
+	if j.Color == "" {
+		return fmt.Errorf("pigments: pigment %q has no color", j.Name)
+	}
+	rgb, err := colorparse.ParseRGB(j.Color)
+	if err != nil {
+		return fmt.Errorf("pigments: pigment %q: %w", j.Name, err)
+	}
+	*p = Pigment{Name: j.Name, Code: j.Code, RGB: rgb, Opacity: j.Opacity, Staining: j.Staining}
+	return nil
+}
+
+// MarshalJSON writes the palette in the palette file format.
+func (p *Palette) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonPalette{p.name, p.pigments})
+}
+
+// UnmarshalJSON reads and validates a palette in the palette file format.
+func (p *Palette) UnmarshalJSON(data []byte) error {
+	var j jsonPalette
+	if err := strictUnmarshal(data, &j); err != nil {
+		return err
+	}
+	q, err := NewPalette(j.Name, j.Pigments)
+	if err != nil {
+		return err
+	}
+	*p = *q
+	return nil
+}
+
+// strictUnmarshal is json.Unmarshal that rejects unknown fields, so typos
+// in hand-edited palette files are reported instead of ignored.
+func strictUnmarshal(data []byte, v any) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	return dec.Decode(v)
+}
+
+// ReadJSON reads a palette file from r.
+func ReadJSON(r io.Reader) (*Palette, error) {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	p := new(Palette)
+	if err := dec.Decode(p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Load reads a palette file. A palette without a name is named after the
+// file.
+func Load(path string) (*Palette, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	p, err := ReadJSON(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if p.name == "" {
+		p.name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	return p, nil
+}
+
+// WriteJSON writes the palette to w as an indented palette file.
+func (p *Palette) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(p)
+}
+
+// Save writes the palette to a file, replacing it if it exists.
+func (p *Palette) Save(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := p.WriteJSON(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,82 @@
+package pigments
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "studio.json")
+	if err := Builtin().Save(path); err != nil {
+		t.Fatal(err)
+	}
+	p, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name() != "Mixbox" || !slices.Equal(p.Pigments(), Builtin().Pigments()) {
+		t.Errorf("loaded %s %v, want the builtin palette", p.Name(), p.Pigments())
+	}
+
+	// Writing what was read gives the same file.
+	var a, b bytes.Buffer
+	if err := Builtin().WriteJSON(&a); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.WriteJSON(&b); err != nil {
+		t.Fatal(err)
+	}
+	if a.String() != b.String() {
+		t.Errorf("palette file changed on a round trip:\n%s\n%s", a.String(), b.String())
+	}
+}
+
+func TestLoadFormat(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "studio.json")
+	err := os.WriteFile(path, []byte(`{
+		"pigments": [
+			{"name": "Cadmium Yellow", "code": "py35", "color": "#feec00", "opacity": "opaque", "staining": "low"},
+			{"name": "Brand Teal", "color": "rgb(0 128 128)"}
+		]
+	}`), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Pigment{
+		{Name: "Cadmium Yellow", Code: "PY35", RGB: [3]uint8{254, 236, 0}, Opacity: Opaque, Staining: LowStaining},
+		{Name: "Brand Teal", RGB: [3]uint8{0, 128, 128}},
+	}
+	if p.Name() != "studio" || !slices.Equal(p.Pigments(), want) {
+		t.Errorf("loaded %s %+v, want studio %+v", p.Name(), p.Pigments(), want)
+	}
+}
+
+func TestReadJSONErrors(t *testing.T) {
+	for _, tt := range []struct{ in, want string }{
+		{`{"pigments": [{"name": "A", "color": "red", "colour": "blue"}]}`, "unknown field"},
+		{`{"pigments": [{"name": "A"}]}`, "has no color"},
+		{`{"pigments": [{"name": "A", "color": "not a color"}]}`, `pigment "A"`},
+		{`{"pigments": [{"name": "A", "color": "red", "opacity": "glossy"}]}`, "unknown opacity"},
+		{`{"pigments": [{"name": "A", "color": "red"}, {"name": "a", "color": "blue"}]}`, "duplicate"},
+		{`{"pigments": [{"name": "", "color": "red"}]}`, "no name"},
+		{`{"pigments": [{"name": "A", "code": "XY1", "color": "red"}]}`, "malformed Color Index code"},
+		{`{"name": "x", "extra": 1}`, "unknown field"},
+		{`[`, "unexpected EOF"},
+	} {
+		_, err := ReadJSON(strings.NewReader(tt.in))
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("ReadJSON(%s): err = %v, want it to mention %q", tt.in, err, tt.want)
+		}
+	}
+	if _, err := Load(filepath.Join(t.TempDir(), "missing.json")); !os.IsNotExist(err) {
+		t.Errorf("Load of a missing file: err = %v", err)
+	}
+}
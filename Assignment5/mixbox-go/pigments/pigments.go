@@ -0,0 +1,308 @@
+// Package pigments is a registry of named artist pigments for use with
+// Mixbox. Each Pigment carries its sRGB color together with its Color Index
+// generic name (PY35, PB29, ...) and handling properties, and pigments are
+// grouped into Palettes that can be looked up by name or code and loaded
+// from and saved to JSON files.
+//
+// Builtin returns the pigments from the Mixbox documentation. Teams can
+// keep their own palettes as JSON; see Load.
+package pigments
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/timf34/mixbox-go/colorparse"
+	"github.com/timf34/mixbox-go/mixbox"
+)
+
+// Pigment is a named pigment.
+type Pigment struct {
+	Name string
+	// Code is the Color Index generic name, such as "PY35" or "PB15:3".
+	// It is empty for convenience mixes such as Sap Green.
+	Code     string
+	RGB      [3]uint8
+	Opacity  Opacity
+	Staining Staining
+}
+
+// Color returns the pigment as a mixbox.Color for use with Mix.
+func (p Pigment) Color() mixbox.Color {
+	return mixbox.RGB(p.RGB)
+}
+
+func (p Pigment) String() string {
+	if p.Code == "" {
+		return p.Name
+	}
+	return p.Name + " (" + p.Code + ")"
+}
+
+// Opacity describes how well a pigment covers what lies beneath it.
+type Opacity int
+
+const (
+	OpacityUnknown Opacity = iota
+	Transparent
+	SemiTransparent
+	SemiOpaque
+	Opaque
+)
+
+var opacityNames = [...]string{"", "transparent", "semi-transparent", "semi-opaque", "opaque"}
+
+func (o Opacity) String() string {
+	if o < 0 || int(o) >= len(opacityNames) {
+		return fmt.Sprintf("Opacity(%d)", int(o))
+	}
+	if o == OpacityUnknown {
+		return "unknown"
+	}
+	return opacityNames[o]
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (o Opacity) MarshalText() ([]byte, error) {
+	if o < 0 || int(o) >= len(opacityNames) {
+		return nil, fmt.Errorf("pigments: invalid opacity %d", int(o))
+	}
+	return []byte(opacityNames[o]), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (o *Opacity) UnmarshalText(text []byte) error {
+	i, err := parseEnum("opacity", string(text), opacityNames[:])
+	*o = Opacity(i)
+	return err
+}
+
+// Staining describes how firmly a pigment binds to paper or canvas, and so
+// how hard it is to lift once dry.
+type Staining int
+
+const (
+	StainingUnknown Staining = iota
+	NonStaining
+	LowStaining
+	ModerateStaining
+	HighStaining
+)
+
+var stainingNames = [...]string{"", "non-staining", "low", "moderate", "high"}
+
+func (s Staining) String() string {
+	if s < 0 || int(s) >= len(stainingNames) {
+		return fmt.Sprintf("Staining(%d)", int(s))
+	}
+	if s == StainingUnknown {
+		return "unknown"
+	}
+	return stainingNames[s]
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Staining) MarshalText() ([]byte, error) {
+	if s < 0 || int(s) >= len(stainingNames) {
+		return nil, fmt.Errorf("pigments: invalid staining %d", int(s))
+	}
+	return []byte(stainingNames[s]), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Staining) UnmarshalText(text []byte) error {
+	i, err := parseEnum("staining", string(text), stainingNames[:])
+	*s = Staining(i)
+	return err
+}
+
+func parseEnum(kind, text string, names []string) (int, error) {
+	text = strings.ToLower(strings.TrimSpace(text))
+	if text == "unknown" {
+		return 0, nil
+	}
+	for i, n := range names {
+		if n == text {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("pigments: unknown %s %q (want one of %s)", kind, text, strings.Join(names[1:], ", "))
+}
+
+var (
+	// ErrNoName is returned for a pigment without a name.
+	ErrNoName = errors.New("pigments: pigment has no name")
+	// ErrDuplicate is returned when two pigments of a palette share a name.
+	ErrDuplicate = errors.New("pigments: duplicate pigment name")
+	// ErrCode is returned for a malformed Color Index code.
+	ErrCode = errors.New("pigments: malformed Color Index code")
+)
+
+// Palette is an ordered, immutable set of pigments with unique names. It is
+// safe for concurrent use.
+type Palette struct {
+	name     string
+	pigments []Pigment
+	byName   map[string]int
+	byCode   map[string]int
+}
+
+// NewPalette validates the pigments and returns them as a Palette. Names
+// must be unique, ignoring case, spaces, hyphens and underscores, and codes
+// must be empty or well-formed Color Index generic names; they are stored
+// in upper case.
+func NewPalette(name string, pigments []Pigment) (*Palette, error) {
+	p := &Palette{
+		name:     name,
+		pigments: make([]Pigment, len(pigments)),
+		byName:   make(map[string]int, len(pigments)),
+		byCode:   make(map[string]int, len(pigments)),
+	}
+	for i, pg := range pigments {
+		key := normalizeName(pg.Name)
+		if key == "" {
+			return nil, fmt.Errorf("%w (entry %d)", ErrNoName, i)
+		}
+		if _, dup := p.byName[key]; dup {
+			return nil, fmt.Errorf("%w %q", ErrDuplicate, pg.Name)
+		}
+		p.byName[key] = i
+		if pg.Code != "" {
+			code, ok := normalizeCode(pg.Code)
+			if !ok {
+				return nil, fmt.Errorf("%w %q (%s)", ErrCode, pg.Code, pg.Name)
+			}
+			pg.Code = code
+			// The first pigment with a code wins lookups by code.
+			if _, seen := p.byCode[code]; !seen {
+				p.byCode[code] = i
+			}
+		}
+		p.pigments[i] = pg
+	}
+	return p, nil
+}
+
+// MustPalette is like NewPalette but panics on error.
+func MustPalette(name string, pigments []Pigment) *Palette {
+	p, err := NewPalette(name, pigments)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// Name returns the palette's name.
+func (p *Palette) Name() string { return p.name }
+
+// Len returns the number of pigments in the palette.
+func (p *Palette) Len() int { return len(p.pigments) }
+
+// Pigments returns a copy of the palette's pigments in order.
+func (p *Palette) Pigments() []Pigment {
+	return append([]Pigment(nil), p.pigments...)
+}
+
+// RGBs returns the colors of the palette's pigments in order.
+func (p *Palette) RGBs() [][3]uint8 {
+	rgbs := make([][3]uint8, len(p.pigments))
+	for i, pg := range p.pigments {
+		rgbs[i] = pg.RGB
+	}
+	return rgbs
+}
+
+// ByName finds a pigment by name, ignoring case, spaces, hyphens and
+// underscores, so "phthalo-blue" finds "Phthalo Blue".
+func (p *Palette) ByName(name string) (Pigment, bool) {
+	i, ok := p.byName[normalizeName(name)]
+	if !ok {
+		return Pigment{}, false
+	}
+	return p.pigments[i], true
+}
+
+// ByCode finds the first pigment with the given Color Index code, ignoring
+// case.
+func (p *Palette) ByCode(code string) (Pigment, bool) {
+	code, ok := normalizeCode(code)
+	if !ok {
+		return Pigment{}, false
+	}
+	i, ok := p.byCode[code]
+	if !ok {
+		return Pigment{}, false
+	}
+	return p.pigments[i], true
+}
+
+// Lookup finds a pigment by name or, failing that, by code.
+func (p *Palette) Lookup(s string) (Pigment, bool) {
+	if pg, ok := p.ByName(s); ok {
+		return pg, true
+	}
+	return p.ByCode(s)
+}
+
+// Resolve returns the pigment named or coded s or, if the palette has
+// none, an ad-hoc pigment of the CSS color s named after its hex value.
+func (p *Palette) Resolve(s string) (Pigment, error) {
+	if pg, ok := p.Lookup(s); ok {
+		return pg, nil
+	}
+	rgb, err := colorparse.ParseRGB(s)
+	if err != nil {
+		return Pigment{}, err
+	}
+	return Pigment{Name: colorparse.FromRGB(rgb).Hex(), RGB: rgb}, nil
+}
+
+func normalizeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '_', '\t':
+			return -1
+		}
+		return r
+	}, strings.ToLower(name))
+}
+
+// colorIndexHues are the hue prefixes of Color Index generic names, longest
+// first.
+var colorIndexHues = []string{"PBR", "PBK", "PY", "PO", "PR", "PV", "PB", "PG", "PW", "PM"}
+
+// normalizeCode upper-cases a Color Index generic name such as "PB15:3" and
+// reports whether it is well-formed: a hue prefix, a number and an optional
+// ":n" variant.
+func normalizeCode(code string) (string, bool) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	for _, hue := range colorIndexHues {
+		rest, ok := strings.CutPrefix(code, hue)
+		if !ok {
+			continue
+		}
+		num, variant, hasVariant := strings.Cut(rest, ":")
+		if !isDigits(num) || hasVariant && !isDigits(variant) {
+			return "", false
+		}
+		// Keep the conventional mixed case of PBr and PBk.
+		if len(hue) == 3 {
+			code = hue[:2] + strings.ToLower(hue[2:]) + rest
+		}
+		return code, true
+	}
+	return "", false
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,170 @@
+package pigments
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestNewPaletteErrors(t *testing.T) {
+	yellow := [3]uint8{254, 236, 0}
+	for _, tt := range []struct {
+		name     string
+		pigments []Pigment
+		want     error
+	}{
+		{"empty name", []Pigment{{Name: "", RGB: yellow}}, ErrNoName},
+		{"blank name", []Pigment{{Name: " -_ ", RGB: yellow}}, ErrNoName},
+		{"duplicate name", []Pigment{{Name: "Cadmium Yellow"}, {Name: "cadmium-yellow"}}, ErrDuplicate},
+		{"duplicate after normalizing", []Pigment{{Name: "Sap Green"}, {Name: "SAP_GREEN"}}, ErrDuplicate},
+		{"bad code", []Pigment{{Name: "Mystery", Code: "PX1"}}, ErrCode},
+		{"code without number", []Pigment{{Name: "Mystery", Code: "PY"}}, ErrCode},
+		{"bad variant", []Pigment{{Name: "Mystery", Code: "PB15:"}}, ErrCode},
+	} {
+		if _, err := NewPalette("test", tt.pigments); !errors.Is(err, tt.want) {
+			t.Errorf("%s: err = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+
+	p, err := NewPalette("empty", nil)
+	if err != nil || p.Len() != 0 {
+		t.Errorf("NewPalette with no pigments = %v, %v", p, err)
+	}
+}
+
+func TestNewPaletteCodes(t *testing.T) {
+	p := MustPalette("test", []Pigment{
+		{Name: "Ultramarine", Code: " pb29 "},
+		{Name: "French Ultramarine", Code: "PB29"},
+		{Name: "Umber", Code: "pbr7"},
+	})
+	if got := []string{p.pigments[0].Code, p.pigments[1].Code, p.pigments[2].Code}; !slices.Equal(got, []string{"PB29", "PB29", "PBr7"}) {
+		t.Errorf("stored codes %q", got)
+	}
+	// Codes may repeat; the first pigment with a code wins.
+	if pg, ok := p.ByCode("PB29"); !ok || pg.Name != "Ultramarine" {
+		t.Errorf("ByCode(PB29) = %v, %v", pg, ok)
+	}
+}
+
+func TestMustPalettePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("MustPalette did not panic on a duplicate name")
+		}
+	}()
+	MustPalette("test", []Pigment{{Name: "a"}, {Name: "A"}})
+}
+
+func TestNormalizeCode(t *testing.T) {
+	for _, tt := range []struct {
+		in, want string
+		ok       bool
+	}{
+		{"PY35", "PY35", true},
+		{"py35", "PY35", true},
+		{" PB15:3 ", "PB15:3", true},
+		{"pbr7", "PBr7", true},
+		{"PBK9", "PBk9", true},
+		{"PW6", "PW6", true},
+		{"PM19", "PM19", true},
+		{"", "", false},
+		{"PY", "", false},
+		{"PX1", "", false},
+		{"PY3a", "", false},
+		{"PB15:", "", false},
+		{"PB15:x", "", false},
+		{"PB15:3:1", "", false},
+		{"PY -35", "", false},
+	} {
+		if got, ok := normalizeCode(tt.in); got != tt.want || ok != tt.ok {
+			t.Errorf("normalizeCode(%q) = %q, %v, want %q, %v", tt.in, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	p := Builtin()
+	for _, s := range []string{"Phthalo Blue", "phthalo-blue", "PHTHALO_BLUE", "pb15:3"} {
+		if pg, ok := p.Lookup(s); !ok || pg != PhthaloBlue {
+			t.Errorf("Lookup(%q) = %v, %v", s, pg, ok)
+		}
+	}
+	for _, s := range []string{"", "Phthalo", "PB15", "#0d1b44"} {
+		if pg, ok := p.Lookup(s); ok {
+			t.Errorf("Lookup(%q) = %v", s, pg)
+		}
+	}
+}
+
+func TestResolvePrecedence(t *testing.T) {
+	p := MustPalette("test", []Pigment{
+		{Name: "Red", RGB: [3]uint8{200, 30, 40}},
+		{Name: "PB29", RGB: [3]uint8{1, 2, 3}},
+		{Name: "Ultramarine", Code: "PB29", RGB: [3]uint8{25, 0, 89}},
+		{Name: "Navy Mix", Code: "PB28", RGB: [3]uint8{0, 33, 133}},
+	})
+	for _, tt := range []struct {
+		in   string
+		want Pigment
+	}{
+		// A name shadows both a code and a CSS color name.
+		{"red", Pigment{Name: "Red", RGB: [3]uint8{200, 30, 40}}},
+		{"pb29", Pigment{Name: "PB29", RGB: [3]uint8{1, 2, 3}}},
+		// A code shadows CSS.
+		{"PB28", Pigment{Name: "Navy Mix", Code: "PB28", RGB: [3]uint8{0, 33, 133}}},
+		// Anything else is parsed as a CSS color named after its hex value.
+		{"navy", Pigment{Name: "#000080", RGB: [3]uint8{0, 0, 128}}},
+		{"rgb(255 0 0 / 0.5)", Pigment{Name: "#ff0000", RGB: [3]uint8{255, 0, 0}}},
+	} {
+		got, err := p.Resolve(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("Resolve(%q) = %+v, %v, want %+v", tt.in, got, err, tt.want)
+		}
+	}
+	if _, err := p.Resolve("PB15"); err == nil {
+		t.Error(`Resolve("PB15") succeeded`)
+	}
+}
+
+func TestDefault(t *testing.T) {
+	if Default() != Builtin() {
+		t.Fatal("Default is not Builtin before SetDefault")
+	}
+	custom := MustPalette("custom", []Pigment{CadmiumYellow})
+	SetDefault(custom)
+	defer SetDefault(Builtin())
+	if Default() != custom {
+		t.Error("SetDefault did not replace the default palette")
+	}
+}
+
+func TestEnumText(t *testing.T) {
+	for o := OpacityUnknown; o <= Opaque; o++ {
+		text, err := o.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var back Opacity
+		if err := back.UnmarshalText(text); err != nil || back != o {
+			t.Errorf("opacity %v: %q reads back as %v, %v", o, text, back, err)
+		}
+	}
+	for s := StainingUnknown; s <= HighStaining; s++ {
+		text, err := s.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var back Staining
+		if err := back.UnmarshalText(text); err != nil || back != s {
+			t.Errorf("staining %v: %q reads back as %v, %v", s, text, back, err)
+		}
+	}
+	if _, err := Opacity(9).MarshalText(); err == nil {
+		t.Error("Opacity(9) marshals")
+	}
+	var o Opacity
+	if err := o.UnmarshalText([]byte("glossy")); err == nil {
+		t.Error(`opacity "glossy" unmarshals`)
+	}
+}
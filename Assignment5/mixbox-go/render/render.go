@@ -0,0 +1,361 @@
+// Package render serves gradients and mixed-color swatches as images, for
+// clients that cannot run JavaScript, such as email templates and
+// documentation generators:
+//
+//	/render/gradient.png?color=PY35&color=ultramarine+blue&width=512&height=64
+//	/render/gradient.svg?color=red&color=white&orientation=vertical
+//	/render/swatch.png?color=%23feec00&weight=3&color=PB29&weight=1&size=64
+//	/render/gradient.json?color=red&color=white&stops=11
+//
+// Colors are given by repeated color parameters, in any CSS color syntax
+// or as the name or Color Index code of a pigment in the handler's
+// palette. Images are drawn by the gradient package, the same code the
+// demos and the mixbox command use; gradient.json returns evenly spaced
+// samples of the gradient and its CSS instead of an image, for pages that
+// style elements themselves. Invalid parameters get a plain-text 400
+// response.
+package render
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image"
+	"image/png"
+	"log"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/timf34/mixbox-go/colorparse"
+	"github.com/timf34/mixbox-go/gradient"
+	"github.com/timf34/mixbox-go/internal/mixing"
+	"github.com/timf34/mixbox-go/mixbox"
+	"github.com/timf34/mixbox-go/pigments"
+)
+
+// Prefix is the path under which images are served.
+const Prefix = "/render"
+
+// Limits on requests.
+const (
+	// MaxSize is the largest width or height of an image.
+	MaxSize = 4096
+	// MaxColors is the most colors a gradient or swatch may have.
+	MaxColors = mixing.MaxColors
+	// MaxStops is the most stops gradient.json may sample.
+	MaxStops = mixing.MaxStops
+)
+
+// Default image sizes. A vertical gradient swaps the gradient defaults.
+const (
+	DefaultGradientWidth  = 512
+	DefaultGradientHeight = 64
+	DefaultSwatchSize     = 64
+	DefaultStops          = mixing.DefaultStops
+)
+
+// cacheControl lets clients and proxies keep an image for an hour: the
+// same parameters always draw the same image, unless the server is
+// reconfigured.
+const cacheControl = "public, max-age=3600"
+
+// Handler serves the images. It is safe for concurrent use.
+type Handler struct {
+	src mixing.Source
+	mux *http.ServeMux
+}
+
+// Option configures a Handler.
+type Option func(*Handler) error
+
+// WithMixer mixes with m instead of the default Mixer.
+func WithMixer(m *mixbox.Mixer) Option {
+	return func(h *Handler) error {
+		if err := h.src.SetMixer(m); err != nil {
+			return fmt.Errorf("render: %w", err)
+		}
+		return nil
+	}
+}
+
+// WithPalette resolves pigment names and codes in p instead of the default
+// palette; see pigments.Default.
+func WithPalette(p *pigments.Palette) Option {
+	return func(h *Handler) error {
+		if err := h.src.SetPalette(p); err != nil {
+			return fmt.Errorf("render: %w", err)
+		}
+		return nil
+	}
+}
+
+// New returns a Handler for the paths under Prefix.
+func New(opts ...Option) (*Handler, error) {
+	h := &Handler{mux: http.NewServeMux()}
+	for _, opt := range opts {
+		if err := opt(h); err != nil {
+			return nil, err
+		}
+	}
+	h.mux.HandleFunc("GET "+Prefix+"/gradient.png", h.gradientPNG)
+	h.mux.HandleFunc("GET "+Prefix+"/gradient.svg", h.gradientSVG)
+	h.mux.HandleFunc("GET "+Prefix+"/gradient.json", h.gradientJSON)
+	h.mux.HandleFunc("GET "+Prefix+"/swatch.png", h.swatchPNG)
+	return h, nil
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// gradient parses the parameters every gradient format takes: color (2 or
+// more) and easing.
+func (h *Handler) gradient(q url.Values) (*gradient.Gradient, error) {
+	colors, err := h.colors(q, 2)
+	if err != nil {
+		return nil, err
+	}
+	easing := gradient.Easing(gradient.Linear)
+	if name := q.Get("easing"); name != "" {
+		var ok bool
+		if easing, ok = gradient.EasingByName(name); !ok {
+			return nil, fmt.Errorf("unknown easing %q", name)
+		}
+	}
+	return gradient.Even(colors, gradient.WithMixer(h.src.Mixer()), gradient.WithEasing(easing))
+}
+
+// gradientImage parses the parameters of the image formats: those of
+// gradient, orientation, width and height.
+func (h *Handler) gradientImage(q url.Values) (g *gradient.Gradient, width, height int, o gradient.Orientation, err error) {
+	if g, err = h.gradient(q); err != nil {
+		return
+	}
+	if o, err = orientation(q.Get("orientation")); err != nil {
+		return
+	}
+	width, height = DefaultGradientWidth, DefaultGradientHeight
+	if o == gradient.Vertical {
+		width, height = height, width
+	}
+	width, height, err = size(q, width, height)
+	return
+}
+
+func (h *Handler) gradientPNG(w http.ResponseWriter, r *http.Request) {
+	g, width, height, o, err := h.gradientImage(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	g.Draw(img, img.Bounds(), o)
+	writePNG(w, r, img)
+}
+
+func (h *Handler) gradientSVG(w http.ResponseWriter, r *http.Request) {
+	g, width, height, o, err := h.gradientImage(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "image/svg+xml")
+	w.Header().Set("Cache-Control", cacheControl)
+	if err := g.WriteSVGOriented(w, width, height, o); err != nil {
+		log.Printf("render: %s: %v", r.URL.Path, err)
+	}
+}
+
+// gradientStop is one sample in a gradient.json response.
+type gradientStop struct {
+	Position float64 `json:"position"`
+	Color    string  `json:"color"`
+}
+
+// gradientJSON samples the gradient at the stops parameter (default
+// DefaultStops) evenly spaced positions and returns the samples with the
+// resolved colors and a CSS linear-gradient running along orientation.
+func (h *Handler) gradientJSON(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	g, err := h.gradient(q)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	o, err := orientation(q.Get("orientation"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	stops := DefaultStops
+	if s := q.Get("stops"); s != "" {
+		if stops, err = strconv.Atoi(s); err != nil || stops < 2 || stops > MaxStops {
+			http.Error(w, fmt.Sprintf("stops: want 2 to %d, got %q", MaxStops, s), http.StatusBadRequest)
+			return
+		}
+	}
+
+	var resp struct {
+		Colors []string       `json:"colors"`
+		Stops  []gradientStop `json:"stops"`
+		CSS    string         `json:"css"`
+	}
+	for _, s := range g.Stops() {
+		resp.Colors = append(resp.Colors, colorparse.FromRGB(s.Color).Hex())
+	}
+	for i, rgb := range g.Sample(stops) {
+		resp.Stops = append(resp.Stops, gradientStop{float64(i) / float64(stops-1), colorparse.FromRGB(rgb).Hex()})
+	}
+	direction := "to right"
+	if o == gradient.Vertical {
+		direction = "to bottom"
+	}
+	resp.CSS = g.CSS(direction)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", cacheControl)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.Printf("render: %s: %v", r.URL.Path, err)
+	}
+}
+
+// swatchPNG mixes one or more colors, in proportion to optional weight
+// parameters, and draws the mix as a solid rectangle. size sets both
+// dimensions; width and height override it.
+func (h *Handler) swatchPNG(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	colors, err := h.colors(q, 1)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	weights, err := weights(q, len(colors))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	side := DefaultSwatchSize
+	if s := q.Get("size"); s != "" {
+		if side, err = dimension("size", s); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	width, height, err := size(q, side, side)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	mix := make([]mixbox.Color, len(colors))
+	for i, c := range colors {
+		mix[i] = mixbox.RGB(c)
+	}
+	latent, err := h.src.Mixer().Mix(mix, weights)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	gradient.DrawColors(img, img.Bounds(), [][3]uint8{latent.RGB()}, gradient.Horizontal)
+	writePNG(w, r, img)
+}
+
+func writePNG(w http.ResponseWriter, r *http.Request, img image.Image) {
+	w.Header().Set("Content-Type", "image/png")
+	w.Header().Set("Cache-Control", cacheControl)
+	bw := bufio.NewWriter(w)
+	err := png.Encode(bw, img)
+	if err == nil {
+		err = bw.Flush()
+	}
+	if err != nil {
+		log.Printf("render: %s: %v", r.URL.Path, err)
+	}
+}
+
+// colors resolves the color parameters; there must be at least `least` of
+// them.
+func (h *Handler) colors(q url.Values, least int) ([][3]uint8, error) {
+	names := q["color"]
+	if len(names) < least || len(names) > MaxColors {
+		return nil, fmt.Errorf("need %d to %d color parameters, got %d", least, MaxColors, len(names))
+	}
+	palette := h.src.Palette()
+	colors := make([][3]uint8, len(names))
+	for i, name := range names {
+		p, err := palette.Resolve(name)
+		if err != nil {
+			return nil, fmt.Errorf("color %d: %v", i+1, err)
+		}
+		colors[i] = p.RGB
+	}
+	return colors, nil
+}
+
+// weights parses the weight parameters, which are either absent, giving
+// equal weights, or one per color.
+func weights(q url.Values, n int) ([]float64, error) {
+	ws := make([]float64, n)
+	params := q["weight"]
+	if len(params) == 0 {
+		for i := range ws {
+			ws[i] = 1
+		}
+		return ws, nil
+	}
+	if len(params) != n {
+		return nil, fmt.Errorf("got %d weight parameters for %d colors", len(params), n)
+	}
+	total := 0.0
+	for i, s := range params {
+		w, err := strconv.ParseFloat(s, 64)
+		if err != nil || !(w >= 0) || math.IsInf(w, 1) {
+			return nil, fmt.Errorf("weight %d: want a non-negative number, got %q", i+1, s)
+		}
+		ws[i] = w
+		total += w
+	}
+	if total == 0 {
+		return nil, errors.New("weights must not all be zero")
+	}
+	return ws, nil
+}
+
+// size parses the width and height parameters, defaulting to width and
+// height.
+func size(q url.Values, width, height int) (int, int, error) {
+	var err error
+	if s := q.Get("width"); s != "" {
+		if width, err = dimension("width", s); err != nil {
+			return 0, 0, err
+		}
+	}
+	if s := q.Get("height"); s != "" {
+		if height, err = dimension("height", s); err != nil {
+			return 0, 0, err
+		}
+	}
+	return width, height, nil
+}
+
+func dimension(name, s string) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 1 || n > MaxSize {
+		return 0, fmt.Errorf("%s: want 1 to %d pixels, got %q", name, MaxSize, s)
+	}
+	return n, nil
+}
+
+func orientation(s string) (gradient.Orientation, error) {
+	switch s {
+	case "", "horizontal":
+		return gradient.Horizontal, nil
+	case "vertical":
+		return gradient.Vertical, nil
+	}
+	return 0, fmt.Errorf("orientation: want horizontal or vertical, got %q", s)
+}
@@ -0,0 +1,255 @@
+package render
+
+import (
+	"encoding/json"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/timf34/mixbox-go/colorparse"
+	"github.com/timf34/mixbox-go/gradient"
+	"github.com/timf34/mixbox-go/mixbox"
+	"github.com/timf34/mixbox-go/pigments"
+)
+
+// testHandler returns a Handler on the embedded table, skipping the test
+// when the mixbox package was built without one.
+func testHandler(t *testing.T, opts ...Option) *Handler {
+	t.Helper()
+	if _, ok := mixbox.DefaultOK(); !ok {
+		t.Skip("built without an embedded LUT")
+	}
+	h, err := New(opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+func get(h http.Handler, target string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, target, nil))
+	return w
+}
+
+func TestGradientJSON(t *testing.T) {
+	h := testHandler(t)
+	w := get(h, Prefix+"/gradient.json?color=%23feec00&color=PB29&stops=5")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status %d: %s", w.Code, w.Body)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Content-Type %q", ct)
+	}
+	var resp struct {
+		Colors []string
+		Stops  []struct {
+			Position float64
+			Color    string
+		}
+		CSS string
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	g, err := gradient.Even([][3]uint8{{254, 236, 0}, {25, 0, 89}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Colors) != 2 || resp.Colors[0] != "#feec00" || resp.Colors[1] != "#190059" {
+		t.Errorf("colors %q", resp.Colors)
+	}
+	samples := g.Sample(5)
+	if len(resp.Stops) != len(samples) {
+		t.Fatalf("%d stops, want %d", len(resp.Stops), len(samples))
+	}
+	for i, s := range resp.Stops {
+		if want := colorparse.FromRGB(samples[i]).Hex(); s.Color != want || s.Position != float64(i)/4 {
+			t.Errorf("stop %d = %+v, want %s at %v", i, s, want, float64(i)/4)
+		}
+	}
+	if want := g.CSS("to right"); resp.CSS != want {
+		t.Errorf("css %s, want %s", resp.CSS, want)
+	}
+
+	resp.Stops = nil
+	w = get(h, Prefix+"/gradient.json?color=red&color=blue&orientation=vertical")
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Stops) != DefaultStops || !strings.HasPrefix(resp.CSS, "linear-gradient(to bottom, ") {
+		t.Errorf("vertical gradient: %d stops, css %s", len(resp.Stops), resp.CSS)
+	}
+}
+
+func TestGradientPNG(t *testing.T) {
+	h := testHandler(t)
+	for _, tt := range []struct {
+		query         string
+		width, height int
+	}{
+		{"color=%23feec00&color=PB29", DefaultGradientWidth, DefaultGradientHeight},
+		{"color=red&color=white&orientation=vertical", DefaultGradientHeight, DefaultGradientWidth},
+		{"color=red&color=white&color=blue&width=40&height=3&easing=ease-in-out", 40, 3},
+		{"color=red&color=white&width=4096&height=1", MaxSize, 1},
+	} {
+		w := get(h, Prefix+"/gradient.png?"+tt.query)
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s: status %d: %s", tt.query, w.Code, w.Body)
+		}
+		if ct, cc := w.Header().Get("Content-Type"), w.Header().Get("Cache-Control"); ct != "image/png" || cc != cacheControl {
+			t.Errorf("%s: Content-Type %q, Cache-Control %q", tt.query, ct, cc)
+		}
+		img, err := png.Decode(w.Body)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.query, err)
+		}
+		if b := img.Bounds(); b.Dx() != tt.width || b.Dy() != tt.height {
+			t.Errorf("%s: image is %v, want %dx%d", tt.query, b, tt.width, tt.height)
+		}
+	}
+
+	// The image is the one gradient.Draw produces.
+	w := get(h, Prefix+"/gradient.png?color=%23feec00&color=PB29&width=16&height=2")
+	img, err := png.Decode(w.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g, err := gradient.Even([][3]uint8{{254, 236, 0}, {25, 0, 89}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := image.NewNRGBA(image.Rect(0, 0, 16, 2))
+	g.Draw(want, want.Bounds(), gradient.Horizontal)
+	for x := 0; x < 16; x++ {
+		if got, exp := color.NRGBAModel.Convert(img.At(x, 1)), want.At(x, 1); got != exp {
+			t.Errorf("pixel %d = %v, want %v", x, got, exp)
+		}
+	}
+}
+
+func TestGradientSVG(t *testing.T) {
+	h := testHandler(t)
+	w := get(h, Prefix+"/gradient.svg?color=red&color=blue&width=100&height=10")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status %d: %s", w.Code, w.Body)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "image/svg+xml" {
+		t.Errorf("Content-Type %q", ct)
+	}
+	g, err := gradient.Even([][3]uint8{{255, 0, 0}, {0, 0, 255}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want strings.Builder
+	if err := g.WriteSVGOriented(&want, 100, 10, gradient.Horizontal); err != nil {
+		t.Fatal(err)
+	}
+	if w.Body.String() != want.String() {
+		t.Errorf("SVG:\n%s\nwant:\n%s", w.Body, want.String())
+	}
+}
+
+func TestSwatchPNG(t *testing.T) {
+	h := testHandler(t)
+	m := mixbox.Default()
+	yellow, blue := [3]uint8{254, 236, 0}, [3]uint8{25, 0, 89}
+	for _, tt := range []struct {
+		query         string
+		want          [3]uint8
+		width, height int
+	}{
+		{"color=%23feec00", yellow, DefaultSwatchSize, DefaultSwatchSize},
+		{"color=%23feec00&color=PB29", m.Lerp(yellow, blue, 0.5), DefaultSwatchSize, DefaultSwatchSize},
+		{"color=%23feec00&weight=3&color=PB29&weight=1&size=8", m.Lerp(yellow, blue, 0.25), 8, 8},
+		{"color=%23feec00&weight=0&color=PB29&weight=1&size=8&height=2", blue, 8, 2},
+	} {
+		w := get(h, Prefix+"/swatch.png?"+tt.query)
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s: status %d: %s", tt.query, w.Code, w.Body)
+		}
+		img, err := png.Decode(w.Body)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.query, err)
+		}
+		if b := img.Bounds(); b.Dx() != tt.width || b.Dy() != tt.height {
+			t.Errorf("%s: image is %v, want %dx%d", tt.query, b, tt.width, tt.height)
+		}
+		c := color.NRGBAModel.Convert(img.At(tt.width-1, tt.height-1)).(color.NRGBA)
+		if got := [3]uint8{c.R, c.G, c.B}; got != tt.want || c.A != 0xff {
+			t.Errorf("%s: color %v, want %v", tt.query, c, tt.want)
+		}
+	}
+}
+
+func TestBadParameters(t *testing.T) {
+	h := testHandler(t)
+	many := strings.Repeat("color=red&", MaxColors+1)
+	for _, tt := range []struct{ path, query, want string }{
+		{"/gradient.png", "color=red", "need 2 to 16 color parameters, got 1"},
+		{"/gradient.png", many, "got 17"},
+		{"/gradient.png", "color=red&color=nope", "color 2"},
+		{"/gradient.png", "color=red&color=blue&easing=bouncy", `unknown easing "bouncy"`},
+		{"/gradient.png", "color=red&color=blue&orientation=diagonal", "orientation"},
+		{"/gradient.png", "color=red&color=blue&width=0", "width: want 1 to 4096 pixels"},
+		{"/gradient.png", "color=red&color=blue&height=4097", "height: want 1 to 4096 pixels"},
+		{"/gradient.png", "color=red&color=blue&width=wide", `"wide"`},
+		{"/gradient.svg", "color=red&color=blue&width=5000", "width"},
+		{"/gradient.json", "color=red&color=blue&stops=1", "stops: want 2 to 256"},
+		{"/gradient.json", "color=red&color=blue&stops=257", "stops: want 2 to 256"},
+		{"/gradient.json", "color=red", "need 2 to 16"},
+		{"/swatch.png", "", "need 1 to 16 color parameters, got 0"},
+		{"/swatch.png", many, "got 17"},
+		{"/swatch.png", "color=red&color=blue&weight=1", "got 1 weight parameters for 2 colors"},
+		{"/swatch.png", "color=red&weight=-1", "weight 1: want a non-negative number"},
+		{"/swatch.png", "color=red&weight=NaN", "weight 1"},
+		{"/swatch.png", "color=red&weight=Inf", "weight 1"},
+		{"/swatch.png", "color=red&color=blue&weight=0&weight=0", "weights must not all be zero"},
+		{"/swatch.png", "color=red&size=0", "size: want 1 to 4096 pixels"},
+		{"/swatch.png", "color=red&size=8&width=9999", "width"},
+	} {
+		w := get(h, Prefix+tt.path+"?"+tt.query)
+		if w.Code != http.StatusBadRequest || !strings.Contains(w.Body.String(), tt.want) {
+			t.Errorf("%s?%s: status %d %q, want 400 mentioning %q", tt.path, tt.query, w.Code, strings.TrimSpace(w.Body.String()), tt.want)
+		}
+	}
+}
+
+func TestRoutes(t *testing.T) {
+	h := testHandler(t)
+	if w := get(h, Prefix+"/gradient.gif?color=red&color=blue"); w.Code != http.StatusNotFound {
+		t.Errorf("unknown format: status %d", w.Code)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, Prefix+"/swatch.png?color=red", nil))
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("POST: status %d", w.Code)
+	}
+}
+
+func TestOptions(t *testing.T) {
+	if _, err := New(WithMixer(nil)); err == nil || !strings.HasPrefix(err.Error(), "render: ") {
+		t.Errorf("WithMixer(nil): err = %v", err)
+	}
+	if _, err := New(WithPalette(nil)); err == nil {
+		t.Error("WithPalette(nil) succeeded")
+	}
+	custom := pigments.MustPalette("custom", []pigments.Pigment{{Name: "Brand Teal", RGB: [3]uint8{0, 128, 128}}})
+	h := testHandler(t, WithPalette(custom))
+	w := get(h, Prefix+"/swatch.png?color=brand-teal&size=1")
+	img, err := png.Decode(w.Body)
+	if err != nil {
+		t.Fatalf("status %d: %s", w.Code, w.Body)
+	}
+	if c := color.NRGBAModel.Convert(img.At(0, 0)).(color.NRGBA); c != (color.NRGBA{0, 128, 128, 255}) {
+		t.Errorf("brand-teal swatch is %v", c)
+	}
+	// The builtin pigments are no longer known.
+	if w := get(h, Prefix+"/swatch.png?color=PB29"); w.Code != http.StatusBadRequest {
+		t.Errorf("PB29 with a custom palette: status %d", w.Code)
+	}
+}
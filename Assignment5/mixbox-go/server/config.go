@@ -0,0 +1,135 @@
+package server
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Config configures a Server. Start from DefaultConfig, then apply
+// LoadEnv and RegisterFlags so that flags override environment variables,
+// which override the defaults.
+type Config struct {
+	// Addr is the TCP address to listen on.
+	Addr string
+	// LUTPath is the lookup table file to mix with, in any format
+	// mixbox.ParseLUT understands; empty for the embedded table.
+	LUTPath string
+	// PalettePath is the JSON palette file pigment names are resolved in,
+	// as read by pigments.Load; empty for the built-in pigments.
+	PalettePath string
+	// ReloadInterval is how often the LUT and palette files are checked
+	// for changes. Zero disables polling; Reload still works.
+	ReloadInterval time.Duration
+
+	// ReadTimeout bounds reading a request, body included, and
+	// WriteTimeout writing its response. IdleTimeout closes keep-alive
+	// connections that send nothing for that long. Zero means no limit.
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+	IdleTimeout  time.Duration
+	// ShutdownTimeout is how long Run waits for requests in flight to
+	// finish after it is asked to stop.
+	ShutdownTimeout time.Duration
+}
+
+// DefaultConfig returns the configuration used when nothing is set.
+func DefaultConfig() Config {
+	return Config{
+		Addr:            ":8080",
+		ReloadInterval:  2 * time.Second,
+		ReadTimeout:     10 * time.Second,
+		WriteTimeout:    30 * time.Second,
+		IdleTimeout:     2 * time.Minute,
+		ShutdownTimeout: 15 * time.Second,
+	}
+}
+
+// setting is a configurable field with its flag and environment variable.
+type setting struct {
+	flag, env, usage string
+	value            flag.Value
+}
+
+func (c *Config) settings() []setting {
+	return []setting{
+		{"addr", "MIXBOX_ADDR", "listen `address`", stringValue{&c.Addr}},
+		{"lut", "MIXBOX_LUT", "lookup table `file` (default: the embedded table)", stringValue{&c.LUTPath}},
+		{"palette", "MIXBOX_PALETTE", "JSON palette `file` (default: the built-in pigments)", stringValue{&c.PalettePath}},
+		{"reload-interval", "MIXBOX_RELOAD_INTERVAL", "`duration` between checks of the lut and palette files for changes, 0 for none", durationValue{&c.ReloadInterval}},
+		{"read-timeout", "MIXBOX_READ_TIMEOUT", "maximum `duration` of reading a request, 0 for none", durationValue{&c.ReadTimeout}},
+		{"write-timeout", "MIXBOX_WRITE_TIMEOUT", "maximum `duration` of writing a response, 0 for none", durationValue{&c.WriteTimeout}},
+		{"idle-timeout", "MIXBOX_IDLE_TIMEOUT", "maximum `duration` of an idle keep-alive connection, 0 for none", durationValue{&c.IdleTimeout}},
+		{"shutdown-timeout", "MIXBOX_SHUTDOWN_TIMEOUT", "`duration` to let requests finish when stopping", durationValue{&c.ShutdownTimeout}},
+	}
+}
+
+// LoadEnv sets the fields whose environment variables are set: MIXBOX_ADDR,
+// MIXBOX_LUT, MIXBOX_PALETTE, and MIXBOX_RELOAD_INTERVAL,
+// MIXBOX_READ_TIMEOUT, MIXBOX_WRITE_TIMEOUT, MIXBOX_IDLE_TIMEOUT and
+// MIXBOX_SHUTDOWN_TIMEOUT as Go durations such as "30s".
+func (c *Config) LoadEnv() error {
+	for _, s := range c.settings() {
+		if v, ok := os.LookupEnv(s.env); ok {
+			if err := s.value.Set(v); err != nil {
+				return fmt.Errorf("server: %s: %w", s.env, err)
+			}
+		}
+	}
+	return nil
+}
+
+// RegisterFlags defines a flag in fs for each field, defaulting to its
+// current value: -addr, -lut, -palette, -reload-interval, -read-timeout,
+// -write-timeout, -idle-timeout and -shutdown-timeout.
+func (c *Config) RegisterFlags(fs *flag.FlagSet) {
+	for _, s := range c.settings() {
+		fs.Var(s.value, s.flag, s.usage+" (env "+s.env+")")
+	}
+}
+
+func (c *Config) validate() error {
+	for _, s := range c.settings() {
+		if d, ok := s.value.(durationValue); ok && *d.p < 0 {
+			return fmt.Errorf("server: negative %s %v", s.flag, *d.p)
+		}
+	}
+	if c.Addr == "" {
+		return errors.New("server: empty listen address")
+	}
+	return nil
+}
+
+type stringValue struct{ p *string }
+
+func (v stringValue) String() string {
+	if v.p == nil {
+		return ""
+	}
+	return *v.p
+}
+
+func (v stringValue) Set(s string) error {
+	*v.p = s
+	return nil
+}
+
+type durationValue struct{ p *time.Duration }
+
+func (v durationValue) String() string {
+	if v.p == nil {
+		return "0s"
+	}
+	return v.p.String()
+}
+
+func (v durationValue) Set(s string) error {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*v.p = d
+	return nil
+}
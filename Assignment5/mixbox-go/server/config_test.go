@@ -0,0 +1,81 @@
+package server
+
+import (
+	"flag"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConfigPrecedence(t *testing.T) {
+	t.Setenv("MIXBOX_ADDR", ":9000")
+	t.Setenv("MIXBOX_LUT", "/env/lut.dat")
+	t.Setenv("MIXBOX_PALETTE", "/env/palette.json")
+	t.Setenv("MIXBOX_RELOAD_INTERVAL", "5s")
+	t.Setenv("MIXBOX_IDLE_TIMEOUT", "0")
+
+	cfg := DefaultConfig()
+	if err := cfg.LoadEnv(); err != nil {
+		t.Fatal(err)
+	}
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	cfg.RegisterFlags(fs)
+	if err := fs.Parse([]string{"-addr", "127.0.0.1:9001", "-lut", "/flag/lut.dat", "-write-timeout", "1m"}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := Config{
+		Addr:            "127.0.0.1:9001",    // flag over env
+		LUTPath:         "/flag/lut.dat",     // flag over env
+		PalettePath:     "/env/palette.json", // env
+		ReloadInterval:  5 * time.Second,     // env over default
+		ReadTimeout:     10 * time.Second,    // default
+		WriteTimeout:    time.Minute,         // flag over default
+		IdleTimeout:     0,                   // env over default
+		ShutdownTimeout: 15 * time.Second,    // default
+	}
+	if cfg != want {
+		t.Errorf("config %+v\nwant %+v", cfg, want)
+	}
+}
+
+func TestConfigFlagDefaults(t *testing.T) {
+	cfg := DefaultConfig()
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	cfg.RegisterFlags(fs)
+	for name, want := range map[string]string{"addr": ":8080", "lut": "", "reload-interval": "2s", "shutdown-timeout": "15s"} {
+		f := fs.Lookup(name)
+		if f == nil {
+			t.Errorf("no -%s flag", name)
+			continue
+		}
+		if f.DefValue != want || !strings.Contains(f.Usage, "(env MIXBOX_") {
+			t.Errorf("-%s: default %q, usage %q", name, f.DefValue, f.Usage)
+		}
+	}
+}
+
+func TestLoadEnvErrors(t *testing.T) {
+	t.Setenv("MIXBOX_READ_TIMEOUT", "ten seconds")
+	cfg := DefaultConfig()
+	if err := cfg.LoadEnv(); err == nil || !strings.Contains(err.Error(), "MIXBOX_READ_TIMEOUT") {
+		t.Errorf("err = %v, want it to name MIXBOX_READ_TIMEOUT", err)
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	for _, tt := range []struct {
+		change func(*Config)
+		want   string
+	}{
+		{func(c *Config) { c.Addr = "" }, "empty listen address"},
+		{func(c *Config) { c.ReloadInterval = -time.Second }, "negative reload-interval"},
+		{func(c *Config) { c.ShutdownTimeout = -1 }, "negative shutdown-timeout"},
+	} {
+		cfg := DefaultConfig()
+		tt.change(&cfg)
+		if _, err := New(cfg, nil); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("err = %v, want it to mention %q", err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/timf34/mixbox-go/mixbox"
+	"github.com/timf34/mixbox-go/pigments"
+)
+
+// Status describes the data a Server loads from one source.
+type Status struct {
+	// Source is the file the data comes from, or "embedded" or "builtin".
+	Source string `json:"source"`
+	// LoadedAt is when the data in use was validated and installed, nil if
+	// none was.
+	LoadedAt *time.Time `json:"loadedAt,omitempty"`
+	// Detail describes the data in use: how the table mixes the reference
+	// vectors, or the palette's name and size.
+	Detail string `json:"detail,omitempty"`
+	// Error says why the most recent load failed, if it did.
+	Error string `json:"error,omitempty"`
+}
+
+// OK reports whether data from the source is in use.
+func (s *Status) OK() bool {
+	return s.LoadedAt != nil
+}
+
+// resource is data loaded from a file, or built in if path is empty. Only
+// files are reloaded.
+type resource struct {
+	name    string
+	path    string
+	builtin string
+	// load reads and validates the data at path and returns a function
+	// that installs it.
+	load func(path string) (install func(), detail string, err error)
+
+	status atomic.Pointer[Status]
+	// stamp identifies the version of the file last loaded.
+	stamp fileStamp
+}
+
+// fileStamp identifies a version of a file by its modification time and
+// size.
+type fileStamp struct {
+	modTime int64
+	size    int64
+}
+
+func stat(path string) (fileStamp, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return fileStamp{}, err
+	}
+	return fileStamp{fi.ModTime().UnixNano(), fi.Size()}, nil
+}
+
+// reload loads, validates and installs the data, and records the outcome.
+// On failure the data in use stays in use. The caller holds
+// Server.reloadMu.
+func (r *resource) reload() {
+	src := r.path
+	if src == "" {
+		src = r.builtin
+	} else if stamp, err := stat(r.path); err == nil {
+		r.stamp = stamp
+	}
+
+	prev := r.status.Load()
+	install, detail, err := r.load(r.path)
+	if err != nil {
+		st := &Status{Source: src, Error: err.Error()}
+		if prev != nil {
+			st.LoadedAt, st.Detail = prev.LoadedAt, prev.Detail
+		}
+		r.status.Store(st)
+		log.Printf("server: %s %s rejected: %v", r.name, src, err)
+		return
+	}
+	install()
+	now := time.Now()
+	r.status.Store(&Status{Source: src, LoadedAt: &now, Detail: detail})
+	if prev != nil {
+		log.Printf("server: reloaded %s from %s: %s", r.name, src, detail)
+	}
+}
+
+// changed reports whether the file differs from the version last loaded.
+// A file that cannot be read may be in the middle of being replaced, so
+// it counts as unchanged until it reappears.
+func (r *resource) changed() bool {
+	if r.path == "" {
+		return false
+	}
+	stamp, err := stat(r.path)
+	return err == nil && stamp != r.stamp
+}
+
+// Reload rereads the configured LUT and palette files, whether or not they
+// changed, and installs whichever pass validation.
+func (s *Server) Reload() {
+	s.reloadMu.Lock()
+	defer s.reloadMu.Unlock()
+	for _, r := range []*resource{s.lut, s.palette} {
+		if r.path != "" {
+			r.reload()
+		}
+	}
+}
+
+// watch reloads the files that changed every interval until ctx is done.
+func (s *Server) watch(ctx context.Context, interval time.Duration) {
+	tick := time.NewTicker(interval)
+	defer tick.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-tick.C:
+		}
+		s.reloadMu.Lock()
+		for _, r := range []*resource{s.lut, s.palette} {
+			if r.changed() {
+				r.reload()
+			}
+		}
+		s.reloadMu.Unlock()
+	}
+}
+
+// loadLUT reads the table at path, or the embedded one if path is empty,
+// and checks it against the reference vectors.
+func loadLUT(path string) (func(), string, error) {
+	var m *mixbox.Mixer
+	if path == "" {
+		var ok bool
+		if m, ok = mixbox.DefaultOK(); !ok {
+			return nil, "", errors.New("no table embedded and no LUT file configured")
+		}
+	} else {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, "", err
+		}
+		table, err := mixbox.ParseLUTWithChecksum(data, "")
+		if err != nil {
+			return nil, "", err
+		}
+		if m, err = mixbox.NewMixer(table); err != nil {
+			return nil, "", err
+		}
+	}
+	report, err := mixbox.Verify(m)
+	if err != nil {
+		return nil, "", err
+	}
+	if report.MaxDeviation8 > 1 {
+		return nil, "", fmt.Errorf("reference mixes are off by up to %d 8-bit steps: %v", report.MaxDeviation8, report)
+	}
+	return func() { mixbox.SetDefault(m) }, report.String(), nil
+}
+
+// loadPalette reads the palette at path, or the built-in one if path is
+// empty.
+func loadPalette(path string) (func(), string, error) {
+	p := pigments.Builtin()
+	if path != "" {
+		var err error
+		if p, err = pigments.Load(path); err != nil {
+			return nil, "", err
+		}
+		if p.Len() == 0 {
+			return nil, "", errors.New("palette has no pigments")
+		}
+	}
+	return func() { pigments.SetDefault(p) }, fmt.Sprintf("%q, %d pigments", p.Name(), p.Len()), nil
+}
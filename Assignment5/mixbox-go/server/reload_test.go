@@ -0,0 +1,191 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/timf34/mixbox-go/mixbox"
+	"github.com/timf34/mixbox-go/pigments"
+)
+
+// tables returns the module's lookup table as shipped (interleaved) and
+// decoded (raw), two valid files of different sizes.
+func tables(t *testing.T) (interleaved, raw []byte) {
+	t.Helper()
+	interleaved, err := os.ReadFile("../mixbox/lut.dat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if raw, err = mixbox.ParseLUT(interleaved); err != nil {
+		t.Fatal(err)
+	}
+	return interleaved, raw
+}
+
+// replace writes data to path and moves its modification time forward, so
+// that a rewrite within the file system's timestamp resolution is seen.
+func replace(t *testing.T, path string, data []byte) {
+	t.Helper()
+	var next time.Time
+	if fi, err := os.Stat(path); err == nil {
+		next = fi.ModTime().Add(time.Second)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if !next.IsZero() {
+		if err := os.Chtimes(path, next, next); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestReloadLUT(t *testing.T) {
+	keepDefaults(t)
+	interleaved, raw := tables(t)
+	path := filepath.Join(t.TempDir(), "lut.dat")
+	replace(t, path, interleaved)
+	cfg := testConfig(t)
+	cfg.LUTPath = path
+	s := newServer(t, cfg, http.NotFoundHandler())
+
+	first := s.LUTStatus()
+	if !first.OK() || first.Source != path || first.Error != "" {
+		t.Fatalf("initial status %+v", first)
+	}
+	m1 := mixbox.Default()
+
+	// Rejected tables leave the Mixer in use serving, and the server
+	// ready.
+	wrong := append([]byte(nil), raw...)
+	for i := 192; i < len(wrong); i++ {
+		wrong[i] = 0
+	}
+	for _, tt := range []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{"corrupt", []byte("not a lookup table"), "mixbox"},
+		{"truncated", interleaved[:len(interleaved)/2], "mixbox"},
+		{"wrong mixes", wrong, "reference mixes are off"},
+	} {
+		replace(t, path, tt.data)
+		s.Reload()
+		st := s.LUTStatus()
+		if st.Error == "" || !strings.Contains(st.Error, tt.want) {
+			t.Errorf("%s: status %+v, want an error mentioning %q", tt.name, st, tt.want)
+		}
+		if st.LoadedAt != first.LoadedAt || st.Detail != first.Detail || !s.Ready() {
+			t.Errorf("%s: status %+v no longer describes the table in use", tt.name, st)
+		}
+		if mixbox.Default() != m1 {
+			t.Errorf("%s: the rejected table was installed", tt.name)
+		}
+		if got := mixbox.Default().Lerp([3]uint8{254, 236, 0}, [3]uint8{25, 0, 89}, 0.5); got != m1.Lerp([3]uint8{254, 236, 0}, [3]uint8{25, 0, 89}, 0.5) {
+			t.Errorf("%s: mixing changed to %v", tt.name, got)
+		}
+	}
+
+	// A valid table replaces the Mixer and clears the error.
+	replace(t, path, raw)
+	s.Reload()
+	st := s.LUTStatus()
+	if !st.OK() || st.Error != "" || !st.LoadedAt.After(*first.LoadedAt) {
+		t.Errorf("after a valid table: %+v", st)
+	}
+	if mixbox.Default() == m1 {
+		t.Error("the valid table was not installed")
+	}
+}
+
+func TestReloadPalette(t *testing.T) {
+	keepDefaults(t)
+	path := filepath.Join(t.TempDir(), "studio.json")
+	replace(t, path, []byte(`{"pigments": [{"name": "Brand Teal", "color": "#008080"}]}`))
+	cfg := testConfig(t)
+	cfg.PalettePath = path
+	s := newServer(t, cfg, http.NotFoundHandler())
+	if st := s.PaletteStatus(); !st.OK() || st.Detail != `"studio", 1 pigments` {
+		t.Fatalf("initial status %+v", st)
+	}
+	first := pigments.Default()
+	if _, ok := first.ByName("brand teal"); !ok {
+		t.Fatal("the palette file was not installed")
+	}
+
+	replace(t, path, []byte(`{"pigments": [{"name": "Brand Teal"}]}`))
+	s.Reload()
+	if st := s.PaletteStatus(); !strings.Contains(st.Error, "has no color") || !st.OK() || pigments.Default() != first {
+		t.Errorf("after an invalid palette: %+v", st)
+	}
+
+	replace(t, path, []byte(`{"name": "Studio", "pigments": [{"name": "Brand Teal", "color": "#008080"}, {"name": "Brand Red", "color": "#c0102a"}]}`))
+	s.Reload()
+	if st := s.PaletteStatus(); st.Error != "" || st.Detail != `"Studio", 2 pigments` {
+		t.Errorf("after a valid palette: %+v", st)
+	}
+	if _, ok := pigments.Default().ByName("brand red"); !ok {
+		t.Error("the new palette was not installed")
+	}
+}
+
+// Reload leaves built-in data alone.
+func TestReloadBuiltin(t *testing.T) {
+	keepDefaults(t)
+	s := newServer(t, testConfig(t), http.NotFoundHandler())
+	lut, palette := s.LUTStatus(), s.PaletteStatus()
+	s.Reload()
+	if s.LUTStatus() != lut || s.PaletteStatus() != palette {
+		t.Error("Reload reloaded the embedded table or builtin palette")
+	}
+}
+
+func TestPolling(t *testing.T) {
+	keepDefaults(t)
+	interleaved, raw := tables(t)
+	path := filepath.Join(t.TempDir(), "lut.dat")
+	replace(t, path, interleaved)
+	cfg := testConfig(t)
+	cfg.LUTPath = path
+	cfg.ReloadInterval = 10 * time.Millisecond
+	s := newServer(t, cfg, http.NotFoundHandler())
+	ctx, stop := context.WithCancel(context.Background())
+	runErr := make(chan error, 1)
+	go func() { runErr <- s.Run(ctx) }()
+	defer func() {
+		stop()
+		if err := <-runErr; err != nil {
+			t.Errorf("Run: %v", err)
+		}
+	}()
+
+	first, m1 := s.LUTStatus(), mixbox.Default()
+	replace(t, path, raw)
+	waitFor(t, func() bool { return s.LUTStatus() != first })
+	if st := s.LUTStatus(); !st.OK() || st.Error != "" || mixbox.Default() == m1 {
+		t.Fatalf("after rewriting the table: %+v", st)
+	}
+
+	second, m2 := s.LUTStatus(), mixbox.Default()
+	replace(t, path, []byte("corrupt"))
+	waitFor(t, func() bool { return s.LUTStatus() != second })
+	if st := s.LUTStatus(); st.Error == "" || st.LoadedAt != second.LoadedAt || mixbox.Default() != m2 {
+		t.Errorf("after corrupting the table: %+v", st)
+	}
+
+	// A file that disappears is not reloaded; the status stays as it was.
+	third := s.LUTStatus()
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(50 * time.Millisecond)
+	if s.LUTStatus() != third {
+		t.Errorf("a missing file was reloaded: %+v", s.LUTStatus())
+	}
+}
@@ -0,0 +1,189 @@
+// Package server runs an HTTP handler as a long-lived service: it loads,
+// validates and hot-reloads the lookup table and pigment palette, applies
+// timeouts, answers health checks and shuts down gracefully.
+//
+// Besides the wrapped handler, a Server answers two probes:
+//
+//   - /healthz reports that the process is serving, with status 200.
+//   - /readyz reports, as JSON, whether the lookup table and palette loaded
+//     and passed validation, with status 200 if they did and 503 if not or
+//     while the server shuts down.
+//
+// A table passes validation if it decodes with a valid header and mixes
+// the reference vectors shipped with the mixbox package to within one
+// 8-bit step; a palette, if pigments.Load accepts it and it is not empty.
+// Data that passes is installed with mixbox.SetDefault or
+// pigments.SetDefault, so every handler using the defaults picks it up,
+// and a request already running keeps the version it started with. Data
+// that fails is logged and reported by /readyz, and the previous version
+// stays in use. At startup there is no previous version from the
+// configured file, so the server stays unready.
+//
+// The files are polled for changes every Config.ReloadInterval; Reload
+// rereads them on demand, for example on SIGHUP.
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"sync"
+	"sync/atomic"
+)
+
+// Server serves a handler with the health probes. It is safe for
+// concurrent use.
+type Server struct {
+	cfg      Config
+	srv      *http.Server
+	stopping atomic.Bool
+
+	// reloadMu serializes loads of lut and palette.
+	reloadMu     sync.Mutex
+	lut, palette *resource
+
+	mu         sync.Mutex
+	onShutdown []func()
+}
+
+// New returns a Server for app configured by cfg, and loads the lookup
+// table and palette. It fails only if cfg is invalid; data that does not
+// load makes the server unready instead.
+func New(cfg Config, app http.Handler) (*Server, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	s := &Server{
+		cfg:     cfg,
+		lut:     &resource{name: "lookup table", path: cfg.LUTPath, builtin: "embedded", load: loadLUT},
+		palette: &resource{name: "palette", path: cfg.PalettePath, builtin: "builtin", load: loadPalette},
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /healthz", s.healthz)
+	mux.HandleFunc("GET /readyz", s.readyz)
+	mux.Handle("/", app)
+	s.srv = &http.Server{
+		Addr:         cfg.Addr,
+		Handler:      mux,
+		ReadTimeout:  cfg.ReadTimeout,
+		WriteTimeout: cfg.WriteTimeout,
+		IdleTimeout:  cfg.IdleTimeout,
+	}
+	s.reloadMu.Lock()
+	s.lut.reload()
+	s.palette.reload()
+	s.reloadMu.Unlock()
+	return s, nil
+}
+
+// LUTStatus returns the status of the lookup table.
+func (s *Server) LUTStatus() *Status {
+	return s.lut.status.Load()
+}
+
+// PaletteStatus returns the status of the palette.
+func (s *Server) PaletteStatus() *Status {
+	return s.palette.status.Load()
+}
+
+// Ready reports whether the server should receive traffic: the table and
+// palette loaded and the server is not shutting down.
+func (s *Server) Ready() bool {
+	return s.LUTStatus().OK() && s.PaletteStatus().OK() && !s.stopping.Load()
+}
+
+// RegisterOnShutdown registers f to be called when Run starts shutting
+// down, for handlers such as WebSockets whose connections http.Server does
+// not track. Run waits for f to return, within the shutdown timeout.
+func (s *Server) RegisterOnShutdown(f func()) {
+	s.mu.Lock()
+	s.onShutdown = append(s.onShutdown, f)
+	s.mu.Unlock()
+}
+
+// Run serves until ctx is done, then stops accepting connections and waits
+// up to the shutdown timeout for requests in flight. It returns nil after
+// a clean shutdown.
+func (s *Server) Run(ctx context.Context) error {
+	ln, err := net.Listen("tcp", s.cfg.Addr)
+	if err != nil {
+		return fmt.Errorf("server: %w", err)
+	}
+	errc := make(chan error, 1)
+	go func() { errc <- s.srv.Serve(ln) }()
+
+	wctx, stopWatching := context.WithCancel(ctx)
+	defer stopWatching()
+	if s.cfg.ReloadInterval > 0 {
+		go s.watch(wctx, s.cfg.ReloadInterval)
+	}
+
+	select {
+	case err := <-errc:
+		return fmt.Errorf("server: %w", err)
+	case <-ctx.Done():
+	}
+
+	s.stopping.Store(true)
+	log.Printf("server: shutting down, waiting up to %v for requests in flight", s.cfg.ShutdownTimeout)
+	sctx, cancel := context.WithTimeout(context.Background(), s.cfg.ShutdownTimeout)
+	defer cancel()
+
+	var hooks sync.WaitGroup
+	s.mu.Lock()
+	for _, f := range s.onShutdown {
+		hooks.Add(1)
+		go func() {
+			defer hooks.Done()
+			f()
+		}()
+	}
+	s.mu.Unlock()
+	hooksDone := make(chan struct{})
+	go func() {
+		hooks.Wait()
+		close(hooksDone)
+	}()
+
+	if err := s.srv.Shutdown(sctx); err != nil {
+		s.srv.Close()
+		return fmt.Errorf("server: shutdown: %w", err)
+	}
+	<-errc // http.ErrServerClosed
+	select {
+	case <-hooksDone:
+	case <-sctx.Done():
+		return fmt.Errorf("server: shutdown: %w", sctx.Err())
+	}
+	return nil
+}
+
+func (s *Server) healthz(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	fmt.Fprintln(w, "ok")
+}
+
+type readiness struct {
+	Ready    bool    `json:"ready"`
+	Stopping bool    `json:"stopping,omitempty"`
+	LUT      *Status `json:"lut"`
+	Palette  *Status `json:"palette"`
+}
+
+func (s *Server) readyz(w http.ResponseWriter, r *http.Request) {
+	resp := readiness{
+		Ready:    s.Ready(),
+		Stopping: s.stopping.Load(),
+		LUT:      s.LUTStatus(),
+		Palette:  s.PaletteStatus(),
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if !resp.Ready {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,288 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/timf34/mixbox-go/mixbox"
+	"github.com/timf34/mixbox-go/pigments"
+)
+
+// keepDefaults restores the default Mixer and palette when the test ends,
+// since a Server installs what it loads. Without an embedded table there
+// is no default Mixer to restore, so the test is skipped.
+func keepDefaults(t *testing.T) {
+	t.Helper()
+	m, ok := mixbox.DefaultOK()
+	if !ok {
+		t.Skip("built without an embedded LUT")
+	}
+	p := pigments.Default()
+	t.Cleanup(func() {
+		mixbox.SetDefault(m)
+		pigments.SetDefault(p)
+	})
+}
+
+// testConfig returns the default configuration listening on a free port
+// of the loopback interface.
+func testConfig(t *testing.T) Config {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := ln.Addr().String()
+	ln.Close()
+	cfg := DefaultConfig()
+	cfg.Addr = addr
+	cfg.ReloadInterval = 0
+	return cfg
+}
+
+func newServer(t *testing.T, cfg Config, app http.Handler) *Server {
+	t.Helper()
+	s, err := New(cfg, app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// probe requests path from s without a network connection.
+func probe(s *Server, path string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	s.srv.Handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+	return w
+}
+
+func readyState(t *testing.T, s *Server) (int, readiness) {
+	t.Helper()
+	w := probe(s, "/readyz")
+	if ct := w.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("readyz Content-Type %q", ct)
+	}
+	var r readiness
+	if err := json.Unmarshal(w.Body.Bytes(), &r); err != nil {
+		t.Fatalf("readyz body %s: %v", w.Body, err)
+	}
+	return w.Code, r
+}
+
+func TestProbes(t *testing.T) {
+	keepDefaults(t)
+	s := newServer(t, testConfig(t), http.NotFoundHandler())
+
+	if w := probe(s, "/healthz"); w.Code != http.StatusOK || w.Body.String() != "ok\n" {
+		t.Errorf("healthz: %d %q", w.Code, w.Body)
+	}
+	code, r := readyState(t, s)
+	if code != http.StatusOK || !r.Ready || r.Stopping || !s.Ready() {
+		t.Errorf("readyz: %d %+v", code, r)
+	}
+	if r.LUT.Source != "embedded" || !r.LUT.OK() || r.LUT.Error != "" || !strings.Contains(r.LUT.Detail, "8-bit mismatches") {
+		t.Errorf("lut status %+v", r.LUT)
+	}
+	if r.Palette.Source != "builtin" || !r.Palette.OK() || r.Palette.Detail != `"Mixbox", 13 pigments` {
+		t.Errorf("palette status %+v", r.Palette)
+	}
+	// The app gets everything else.
+	if w := probe(s, "/elsewhere"); w.Code != http.StatusNotFound {
+		t.Errorf("app request: status %d", w.Code)
+	}
+}
+
+func TestUnready(t *testing.T) {
+	keepDefaults(t)
+	dir := t.TempDir()
+	emptyPalette := filepath.Join(dir, "empty.json")
+	if err := os.WriteFile(emptyPalette, []byte(`{"pigments": []}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		name     string
+		change   func(*Config)
+		lut, pal string // expected errors
+	}{
+		{"missing table", func(c *Config) { c.LUTPath = filepath.Join(dir, "missing.dat") }, "no such file", ""},
+		{"missing palette", func(c *Config) { c.PalettePath = filepath.Join(dir, "missing.json") }, "", "no such file"},
+		{"empty palette", func(c *Config) { c.PalettePath = emptyPalette }, "", "palette has no pigments"},
+	} {
+		cfg := testConfig(t)
+		tt.change(&cfg)
+		s := newServer(t, cfg, http.NotFoundHandler())
+		code, r := readyState(t, s)
+		if code != http.StatusServiceUnavailable || r.Ready || s.Ready() {
+			t.Errorf("%s: readyz %d %+v", tt.name, code, r)
+		}
+		for _, st := range []struct {
+			status *Status
+			want   string
+		}{{r.LUT, tt.lut}, {r.Palette, tt.pal}} {
+			if st.want == "" {
+				if !st.status.OK() {
+					t.Errorf("%s: %+v, want it loaded", tt.name, st.status)
+				}
+			} else if st.status.OK() || !strings.Contains(st.status.Error, st.want) {
+				t.Errorf("%s: %+v, want an error mentioning %q", tt.name, st.status, st.want)
+			}
+		}
+		// Liveness does not depend on the data.
+		if w := probe(s, "/healthz"); w.Code != http.StatusOK {
+			t.Errorf("%s: healthz %d", tt.name, w.Code)
+		}
+	}
+}
+
+func TestUnreadyWithoutTable(t *testing.T) {
+	if _, ok := mixbox.DefaultOK(); ok {
+		t.Skip("built with an embedded LUT")
+	}
+	s := newServer(t, testConfig(t), http.NotFoundHandler())
+	code, r := readyState(t, s)
+	if code != http.StatusServiceUnavailable || r.LUT.OK() || !strings.Contains(r.LUT.Error, "no table embedded") {
+		t.Errorf("readyz %d, lut %+v", code, r.LUT)
+	}
+}
+
+func TestGracefulShutdown(t *testing.T) {
+	keepDefaults(t)
+	cfg := testConfig(t)
+	entered, release := make(chan struct{}), make(chan struct{})
+	app := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(entered)
+		<-release
+		io.WriteString(w, "finished")
+	})
+	s := newServer(t, cfg, app)
+	hookCalled := make(chan struct{})
+	s.RegisterOnShutdown(func() { close(hookCalled) })
+
+	ctx, stop := context.WithCancel(context.Background())
+	defer stop()
+	runErr := make(chan error, 1)
+	go func() { runErr <- s.Run(ctx) }()
+	base := "http://" + cfg.Addr
+	// A pooled connection that never carries a request would hold up
+	// Shutdown for seconds.
+	client := &http.Client{Transport: &http.Transport{DisableKeepAlives: true}}
+	waitFor(t, func() bool {
+		resp, err := client.Get(base + "/healthz")
+		if err == nil {
+			resp.Body.Close()
+		}
+		return err == nil
+	})
+
+	body := make(chan string, 1)
+	go func() {
+		resp, err := client.Get(base + "/slow")
+		if err != nil {
+			body <- err.Error()
+			return
+		}
+		defer resp.Body.Close()
+		b, _ := io.ReadAll(resp.Body)
+		body <- string(b)
+	}()
+	<-entered
+	stop()
+
+	select {
+	case <-hookCalled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("shutdown hook not called")
+	}
+	if code, r := readyState(t, s); code != http.StatusServiceUnavailable || !r.Stopping {
+		t.Errorf("readyz while stopping: %d %+v", code, r)
+	}
+	select {
+	case err := <-runErr:
+		t.Fatalf("Run returned %v with a request in flight", err)
+	default:
+	}
+
+	close(release)
+	if got := <-body; got != "finished" {
+		t.Errorf("request in flight got %q", got)
+	}
+	select {
+	case err := <-runErr:
+		if err != nil {
+			t.Errorf("Run: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not return")
+	}
+	if _, err := client.Get(base + "/healthz"); err == nil {
+		t.Error("server still accepts connections after Run returned")
+	}
+}
+
+func TestShutdownTimeout(t *testing.T) {
+	keepDefaults(t)
+	cfg := testConfig(t)
+	cfg.ShutdownTimeout = 50 * time.Millisecond
+	entered, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+	s := newServer(t, cfg, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(entered)
+		<-release
+	}))
+	ctx, stop := context.WithCancel(context.Background())
+	runErr := make(chan error, 1)
+	go func() { runErr <- s.Run(ctx) }()
+	waitFor(t, func() bool {
+		conn, err := net.Dial("tcp", cfg.Addr)
+		if err == nil {
+			conn.Close()
+		}
+		return err == nil
+	})
+	go http.Get("http://" + cfg.Addr + "/stuck")
+	<-entered
+	stop()
+	select {
+	case err := <-runErr:
+		if err == nil || !strings.Contains(err.Error(), "shutdown") {
+			t.Errorf("Run = %v, want a shutdown error", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run ignored the shutdown timeout")
+	}
+}
+
+func TestRunListenError(t *testing.T) {
+	keepDefaults(t)
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	cfg := testConfig(t)
+	cfg.Addr = ln.Addr().String()
+	if err := newServer(t, cfg, http.NotFoundHandler()).Run(context.Background()); err == nil {
+		t.Error("Run succeeded on an address in use")
+	}
+}
+
+// waitFor polls cond until it holds, failing the test after five seconds.
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}